@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"flag"
+	"fmt"
+	"health/config"
+	mongoDb "health/mongodb"
+	"health/pkg/envelope"
+	"log"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Kalitlarni almashtirish tartibi:
+//  1. yangi master key ni MASTER_KEY boshiga qo'shib (eskisi ortida qoladi) -rewrap
+//  2. eski master key ni config dan olib tashlash
+//
+// Data key ni almashtirish (masalan kalit sizib chiqqanda) -rotate-user yoki
+// -rotate-all bilan: yozuvlar yangi data key bilan qayta shifrlanadi.
+//
+// -search-tokens tibbiy yozuvlarning qidiruv tokenlarini qayta hisoblaydi:
+// qidiruv qo'shilgandan va shifrlash yoqilgandan keyin bir marta ishlatiladi.
+// Master key berilmagan bo'lsa tokenlar ochiq so'zlardan tuziladi.
+func main() {
+	generate := flag.String("generate", "", "berilgan id bilan yangi master key chiqarish")
+	rewrap := flag.Bool("rewrap", false, "data key larni faol master key bilan qayta o'rash")
+	rotateUser := flag.String("rotate-user", "", "foydalanuvchi data key ini almashtirish")
+	rotateAll := flag.Bool("rotate-all", false, "barcha foydalanuvchilar data key ini almashtirish")
+	searchTokens := flag.Bool("search-tokens", false, "tibbiy yozuvlar qidiruv tokenlarini qayta hisoblash")
+	flag.Parse()
+
+	if *generate != "" {
+		key, err := envelope.NewDataKey()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s:%s\n", *generate, base64.StdEncoding.EncodeToString(key))
+		return
+	}
+
+	masterKeys, err := envelope.LoadMasterKeys(config.Load().MasterKey, config.Load().MasterKeyFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if masterKeys == nil && (*rewrap || *rotateUser != "" || *rotateAll) {
+		log.Fatal("MASTER_KEY yoki MASTER_KEY_FILE berilmagan")
+	}
+
+	mongoClient, mongodb, err := mongoDb.NewMongoClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer mongoClient.Disconnect(context.Background())
+
+	ctx := context.Background()
+	repo := mongoDb.NewHealth(mongodb, nil, nil)
+	repo.Keys = masterKeys
+
+	if *rewrap {
+		n, err := repo.RewrapDataKeys(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("%d data key(s) rewrapped with master key %s", n, masterKeys.ActiveID())
+	}
+
+	var users []string
+	if *rotateUser != "" {
+		users = append(users, *rotateUser)
+	}
+	if *rotateAll {
+		ids, err := mongodb.Collection("medical_records").Distinct(ctx, "user_id", bson.M{})
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, id := range ids {
+			if s, ok := id.(string); ok && s != "" {
+				users = append(users, s)
+			}
+		}
+	}
+	for _, userID := range users {
+		n, err := repo.RotateDataKey(ctx, userID)
+		if err != nil {
+			log.Fatalf("rotate %s: %v", userID, err)
+		}
+		log.Printf("user %s: %d medical record(s) re-encrypted", userID, n)
+	}
+
+	if *searchTokens {
+		n, err := repo.RebuildSearchTokens(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("search tokens rebuilt for %d medical record(s)", n)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	mongoDb "health/mongodb"
+	"log"
+	"log/slog"
+	"os"
+	"time"
+)
+
+func main() {
+	statusOnly := flag.Bool("status", false, "qo'llangan va kutilayotgan migratsiyalarni ko'rsatish")
+	flag.Parse()
+
+	mongoClient, mongodb, err := mongoDb.NewMongoClient()
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer mongoClient.Disconnect(context.Background())
+
+	ctx := context.Background()
+
+	if *statusOnly {
+		applied, err := mongoDb.AppliedMigrations(ctx, mongodb)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, m := range mongoDb.Migrations {
+			state := "pending"
+			if r, ok := applied[m.Version]; ok {
+				state = "applied " + r.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%4d  %-40s %s\n", m.Version, m.Name, state)
+		}
+		return
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	done, err := mongoDb.Migrate(ctx, mongodb, logger)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%d migration(s) applied", len(done))
+}
@@ -9,6 +9,7 @@ package health_analytics
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,100 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SortOrder ro'yxatlarda saralash yo'nalishi, default yangilari birinchi
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_DESC SortOrder = 0
+	SortOrder_SORT_ORDER_ASC  SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_DESC",
+		1: "SORT_ORDER_ASC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_DESC": 0,
+		"SORT_ORDER_ASC":  1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_enumTypes[0]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{0}
+}
+
+// MedicalRecordSortField ListMedicalRecords saralash maydoni
+type MedicalRecordSortField int32
+
+const (
+	MedicalRecordSortField_MEDICAL_RECORD_SORT_FIELD_RECORD_DATE MedicalRecordSortField = 0
+	MedicalRecordSortField_MEDICAL_RECORD_SORT_FIELD_CREATED_AT  MedicalRecordSortField = 1
+)
+
+// Enum value maps for MedicalRecordSortField.
+var (
+	MedicalRecordSortField_name = map[int32]string{
+		0: "MEDICAL_RECORD_SORT_FIELD_RECORD_DATE",
+		1: "MEDICAL_RECORD_SORT_FIELD_CREATED_AT",
+	}
+	MedicalRecordSortField_value = map[string]int32{
+		"MEDICAL_RECORD_SORT_FIELD_RECORD_DATE": 0,
+		"MEDICAL_RECORD_SORT_FIELD_CREATED_AT":  1,
+	}
+)
+
+func (x MedicalRecordSortField) Enum() *MedicalRecordSortField {
+	p := new(MedicalRecordSortField)
+	*p = x
+	return p
+}
+
+func (x MedicalRecordSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MedicalRecordSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_enumTypes[1].Descriptor()
+}
+
+func (MedicalRecordSortField) Type() protoreflect.EnumType {
+	return &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_enumTypes[1]
+}
+
+func (x MedicalRecordSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MedicalRecordSortField.Descriptor instead.
+func (MedicalRecordSortField) EnumDescriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{1}
+}
+
 type GenerateHealthRecommendationsIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -120,6 +215,10 @@ type GetAllWearableDataResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Wearabledata []*WearableData `protobuf:"bytes,1,rep,name=wearabledata,proto3" json:"wearabledata,omitempty"`
+	// bo'sh bo'lsa boshqa sahifa yo'q
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// filtrga mos yozuvlarning umumiy soni, cursor ga bog'liq emas
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
 func (x *GetAllWearableDataResponse) Reset() {
@@ -161,13 +260,36 @@ func (x *GetAllWearableDataResponse) GetWearabledata() []*WearableData {
 	return nil
 }
 
+func (x *GetAllWearableDataResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetAllWearableDataResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// GetAllWearableData o'chirilmagan o'lchovlarni recorded_timestamp bo'yicha
+// saralab qaytaradi. user_id bo'sh bo'lsa bemor o'z yozuvlarini, admin hammasini
+// ko'radi. from kiradi, to kirmaydi.
 type GetAllWearableDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page  int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit      int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor     string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	UserId     string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType   string                 `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DeviceType string                 `protobuf:"bytes,6,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	From       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=to,proto3" json:"to,omitempty"`
+	SortOrder  SortOrder              `protobuf:"varint,9,opt,name=sort_order,json=sortOrder,proto3,enum=healthanalytics.SortOrder" json:"sort_order,omitempty"`
 }
 
 func (x *GetAllWearableDataRequest) Reset() {
@@ -209,11 +331,53 @@ func (x *GetAllWearableDataRequest) GetLimit() int64 {
 	return 0
 }
 
-func (x *GetAllWearableDataRequest) GetPage() int64 {
+func (x *GetAllWearableDataRequest) GetCursor() string {
 	if x != nil {
-		return x.Page
+		return x.Cursor
 	}
-	return 0
+	return ""
+}
+
+func (x *GetAllWearableDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAllWearableDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *GetAllWearableDataRequest) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *GetAllWearableDataRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAllWearableDataRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAllWearableDataRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_DESC
 }
 
 type GetAllLifestyleDataResponse struct {
@@ -222,6 +386,10 @@ type GetAllLifestyleDataResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Lifestyledata []*LifestyleData `protobuf:"bytes,1,rep,name=lifestyledata,proto3" json:"lifestyledata,omitempty"`
+	// bo'sh bo'lsa boshqa sahifa yo'q
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// filtrga mos yozuvlarning umumiy soni, cursor ga bog'liq emas
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
 func (x *GetAllLifestyleDataResponse) Reset() {
@@ -263,13 +431,35 @@ func (x *GetAllLifestyleDataResponse) GetLifestyledata() []*LifestyleData {
 	return nil
 }
 
+func (x *GetAllLifestyleDataResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetAllLifestyleDataResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// GetAllLifestyleData o'chirilmagan yozuvlarni recorded_date bo'yicha saralab
+// qaytaradi. user_id bo'sh bo'lsa bemor o'z yozuvlarini, admin hammasini
+// ko'radi. from kiradi, to kirmaydi.
 type GetAllLifestyleDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit int64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Page  int64 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor    string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	UserId    string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType  string                 `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	From      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=from,proto3" json:"from,omitempty"`
+	To        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=to,proto3" json:"to,omitempty"`
+	SortOrder SortOrder              `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3,enum=healthanalytics.SortOrder" json:"sort_order,omitempty"`
 }
 
 func (x *GetAllLifestyleDataRequest) Reset() {
@@ -311,34 +501,138 @@ func (x *GetAllLifestyleDataRequest) GetLimit() int64 {
 	return 0
 }
 
-func (x *GetAllLifestyleDataRequest) GetPage() int64 {
+func (x *GetAllLifestyleDataRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetAllLifestyleDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAllLifestyleDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *GetAllLifestyleDataRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAllLifestyleDataRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAllLifestyleDataRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_DESC
+}
+
+// Measurement o'lchovning son qiymati. Qiymat data_type uchun asosiy birlikka
+// keltirilgan bo'ladi (masalan vazn kg da, harorat °C da).
+type Measurement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Unit  string  `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// tarkibli qiymatlar uchun, masalan qon bosimi: systolic, diastolic
+	Components map[string]float64 `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *Measurement) Reset() {
+	*x = Measurement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Measurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Measurement) ProtoMessage() {}
+
+func (x *Measurement) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Measurement.ProtoReflect.Descriptor instead.
+func (*Measurement) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Measurement) GetValue() float64 {
 	if x != nil {
-		return x.Page
+		return x.Value
 	}
 	return 0
 }
 
+func (x *Measurement) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Measurement) GetComponents() map[string]float64 {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
 // Tibbiy yozuvlar uchun message'lar
 type MedicalRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId      string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RecordType  string   `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
-	RecordDate  string   `protobuf:"bytes,4,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
-	Description string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	DoctorId    string   `protobuf:"bytes,6,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Attachments []string `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
-	CreatedAt   string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecordType  string                 `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	RecordDate  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	DoctorId    string                 `protobuf:"bytes,6,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// faqat o'chirilgan yozuvlar ro'yxatida to'ldiriladi
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// UploadAttachment orqali yuklangan fayllar
+	Attachments []*Attachment `protobuf:"bytes,11,rep,name=attachments,proto3" json:"attachments,omitempty"`
 }
 
 func (x *MedicalRecord) Reset() {
 	*x = MedicalRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[6]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +645,7 @@ func (x *MedicalRecord) String() string {
 func (*MedicalRecord) ProtoMessage() {}
 
 func (x *MedicalRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[6]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +658,7 @@ func (x *MedicalRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MedicalRecord.ProtoReflect.Descriptor instead.
 func (*MedicalRecord) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{6}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *MedicalRecord) GetId() string {
@@ -388,11 +682,11 @@ func (x *MedicalRecord) GetRecordType() string {
 	return ""
 }
 
-func (x *MedicalRecord) GetRecordDate() string {
+func (x *MedicalRecord) GetRecordDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.RecordDate
 	}
-	return ""
+	return nil
 }
 
 func (x *MedicalRecord) GetDescription() string {
@@ -409,25 +703,32 @@ func (x *MedicalRecord) GetDoctorId() string {
 	return ""
 }
 
-func (x *MedicalRecord) GetAttachments() []string {
+func (x *MedicalRecord) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Attachments
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MedicalRecord) GetCreatedAt() string {
+func (x *MedicalRecord) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *MedicalRecord) GetUpdatedAt() string {
+func (x *MedicalRecord) GetDeletedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.DeletedAt
 	}
-	return ""
+	return nil
+}
+
+func (x *MedicalRecord) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
 }
 
 type AddMedicalRecordRequest struct {
@@ -435,18 +736,17 @@ type AddMedicalRecordRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId      string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RecordType  string   `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
-	RecordDate  string   `protobuf:"bytes,3,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
-	Description string   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	DoctorId    string   `protobuf:"bytes,5,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Attachments []string `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	UserId      string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecordType  string                 `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	RecordDate  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	DoctorId    string                 `protobuf:"bytes,5,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
 }
 
 func (x *AddMedicalRecordRequest) Reset() {
 	*x = AddMedicalRecordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[7]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -459,7 +759,7 @@ func (x *AddMedicalRecordRequest) String() string {
 func (*AddMedicalRecordRequest) ProtoMessage() {}
 
 func (x *AddMedicalRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[7]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +772,7 @@ func (x *AddMedicalRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMedicalRecordRequest.ProtoReflect.Descriptor instead.
 func (*AddMedicalRecordRequest) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{7}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddMedicalRecordRequest) GetUserId() string {
@@ -489,11 +789,11 @@ func (x *AddMedicalRecordRequest) GetRecordType() string {
 	return ""
 }
 
-func (x *AddMedicalRecordRequest) GetRecordDate() string {
+func (x *AddMedicalRecordRequest) GetRecordDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.RecordDate
 	}
-	return ""
+	return nil
 }
 
 func (x *AddMedicalRecordRequest) GetDescription() string {
@@ -510,13 +810,6 @@ func (x *AddMedicalRecordRequest) GetDoctorId() string {
 	return ""
 }
 
-func (x *AddMedicalRecordRequest) GetAttachments() []string {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
-}
-
 type AddMedicalRecordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -528,7 +821,7 @@ type AddMedicalRecordResponse struct {
 func (x *AddMedicalRecordResponse) Reset() {
 	*x = AddMedicalRecordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[8]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -541,7 +834,7 @@ func (x *AddMedicalRecordResponse) String() string {
 func (*AddMedicalRecordResponse) ProtoMessage() {}
 
 func (x *AddMedicalRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[8]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,7 +847,7 @@ func (x *AddMedicalRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMedicalRecordResponse.ProtoReflect.Descriptor instead.
 func (*AddMedicalRecordResponse) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{8}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AddMedicalRecordResponse) GetMedicalRecord() *MedicalRecord {
@@ -575,7 +868,7 @@ type GetMedicalRecordRequest struct {
 func (x *GetMedicalRecordRequest) Reset() {
 	*x = GetMedicalRecordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[9]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +881,7 @@ func (x *GetMedicalRecordRequest) String() string {
 func (*GetMedicalRecordRequest) ProtoMessage() {}
 
 func (x *GetMedicalRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[9]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +894,7 @@ func (x *GetMedicalRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMedicalRecordRequest.ProtoReflect.Descriptor instead.
 func (*GetMedicalRecordRequest) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{9}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetMedicalRecordRequest) GetId() string {
@@ -622,7 +915,7 @@ type GetMedicalRecordResponse struct {
 func (x *GetMedicalRecordResponse) Reset() {
 	*x = GetMedicalRecordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[10]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -635,7 +928,7 @@ func (x *GetMedicalRecordResponse) String() string {
 func (*GetMedicalRecordResponse) ProtoMessage() {}
 
 func (x *GetMedicalRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[10]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,7 +941,7 @@ func (x *GetMedicalRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMedicalRecordResponse.ProtoReflect.Descriptor instead.
 func (*GetMedicalRecordResponse) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{10}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetMedicalRecordResponse) GetMedicalRecord() *MedicalRecord {
@@ -663,19 +956,18 @@ type UpdateMedicalRecordRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId      string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RecordType  string   `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
-	RecordDate  string   `protobuf:"bytes,4,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
-	Description string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	DoctorId    string   `protobuf:"bytes,6,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Attachments []string `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecordType  string                 `protobuf:"bytes,3,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	RecordDate  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=record_date,json=recordDate,proto3" json:"record_date,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	DoctorId    string                 `protobuf:"bytes,6,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
 }
 
 func (x *UpdateMedicalRecordRequest) Reset() {
 	*x = UpdateMedicalRecordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[11]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -688,7 +980,7 @@ func (x *UpdateMedicalRecordRequest) String() string {
 func (*UpdateMedicalRecordRequest) ProtoMessage() {}
 
 func (x *UpdateMedicalRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[11]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +993,7 @@ func (x *UpdateMedicalRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMedicalRecordRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMedicalRecordRequest) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{11}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateMedicalRecordRequest) GetId() string {
@@ -725,11 +1017,11 @@ func (x *UpdateMedicalRecordRequest) GetRecordType() string {
 	return ""
 }
 
-func (x *UpdateMedicalRecordRequest) GetRecordDate() string {
+func (x *UpdateMedicalRecordRequest) GetRecordDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.RecordDate
 	}
-	return ""
+	return nil
 }
 
 func (x *UpdateMedicalRecordRequest) GetDescription() string {
@@ -746,13 +1038,6 @@ func (x *UpdateMedicalRecordRequest) GetDoctorId() string {
 	return ""
 }
 
-func (x *UpdateMedicalRecordRequest) GetAttachments() []string {
-	if x != nil {
-		return x.Attachments
-	}
-	return nil
-}
-
 type UpdateMedicalRecordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -764,7 +1049,7 @@ type UpdateMedicalRecordResponse struct {
 func (x *UpdateMedicalRecordResponse) Reset() {
 	*x = UpdateMedicalRecordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[12]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -777,7 +1062,7 @@ func (x *UpdateMedicalRecordResponse) String() string {
 func (*UpdateMedicalRecordResponse) ProtoMessage() {}
 
 func (x *UpdateMedicalRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[12]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1075,7 @@ func (x *UpdateMedicalRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMedicalRecordResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMedicalRecordResponse) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{12}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateMedicalRecordResponse) GetSuccess() bool {
@@ -811,7 +1096,7 @@ type DeleteMedicalRecordRequest struct {
 func (x *DeleteMedicalRecordRequest) Reset() {
 	*x = DeleteMedicalRecordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[13]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -824,7 +1109,7 @@ func (x *DeleteMedicalRecordRequest) String() string {
 func (*DeleteMedicalRecordRequest) ProtoMessage() {}
 
 func (x *DeleteMedicalRecordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[13]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -837,7 +1122,7 @@ func (x *DeleteMedicalRecordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMedicalRecordRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMedicalRecordRequest) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{13}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteMedicalRecordRequest) GetId() string {
@@ -858,7 +1143,7 @@ type DeleteMedicalRecordResponse struct {
 func (x *DeleteMedicalRecordResponse) Reset() {
 	*x = DeleteMedicalRecordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[14]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -871,7 +1156,7 @@ func (x *DeleteMedicalRecordResponse) String() string {
 func (*DeleteMedicalRecordResponse) ProtoMessage() {}
 
 func (x *DeleteMedicalRecordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[14]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,7 +1169,7 @@ func (x *DeleteMedicalRecordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMedicalRecordResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMedicalRecordResponse) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{14}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteMedicalRecordResponse) GetSuccess() bool {
@@ -894,18 +1179,32 @@ func (x *DeleteMedicalRecordResponse) GetSuccess() bool {
 	return false
 }
 
+// ListMedicalRecords o'chirilmagan yozuvlarni saralab va cursor bilan
+// sahifalab qaytaradi. user_id bo'sh bo'lsa bemor o'z yozuvlarini ko'radi.
+// from va to record_date uchun, from kiradi, to kirmaydi.
+// query description da so'rovdagi hamma so'zlar bo'lgan yozuvlarni qidiradi
+// (so'zlar to'liq mos kelishi kerak), u faqat bitta bemor yozuvlari ichida ishlaydi.
 type ListMedicalRecordsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecordType string                 `protobuf:"bytes,2,opt,name=record_type,json=recordType,proto3" json:"record_type,omitempty"`
+	DoctorId   string                 `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	From       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=from,proto3" json:"from,omitempty"`
+	To         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=to,proto3" json:"to,omitempty"`
+	Query      string                 `protobuf:"bytes,6,opt,name=query,proto3" json:"query,omitempty"`
+	SortBy     MedicalRecordSortField `protobuf:"varint,7,opt,name=sort_by,json=sortBy,proto3,enum=healthanalytics.MedicalRecordSortField" json:"sort_by,omitempty"`
+	SortOrder  SortOrder              `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3,enum=healthanalytics.SortOrder" json:"sort_order,omitempty"`
+	Limit      int64                  `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor     string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (x *ListMedicalRecordsRequest) Reset() {
 	*x = ListMedicalRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[15]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -918,7 +1217,7 @@ func (x *ListMedicalRecordsRequest) String() string {
 func (*ListMedicalRecordsRequest) ProtoMessage() {}
 
 func (x *ListMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[15]
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -931,7 +1230,7 @@ func (x *ListMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMedicalRecordsRequest.ProtoReflect.Descriptor instead.
 func (*ListMedicalRecordsRequest) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{15}
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListMedicalRecordsRequest) GetUserId() string {
@@ -941,70 +1240,83 @@ func (x *ListMedicalRecordsRequest) GetUserId() string {
 	return ""
 }
 
-type ListMedicalRecordsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ListMedicalRecordsRequest) GetRecordType() string {
+	if x != nil {
+		return x.RecordType
+	}
+	return ""
+}
 
-	MedicalRecords []*MedicalRecord `protobuf:"bytes,1,rep,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+func (x *ListMedicalRecordsRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
 }
 
-func (x *ListMedicalRecordsResponse) Reset() {
-	*x = ListMedicalRecordsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListMedicalRecordsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
 	}
+	return nil
 }
 
-func (x *ListMedicalRecordsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListMedicalRecordsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
 }
 
-func (*ListMedicalRecordsResponse) ProtoMessage() {}
+func (x *ListMedicalRecordsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
 
-func (x *ListMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListMedicalRecordsRequest) GetSortBy() MedicalRecordSortField {
+	if x != nil {
+		return x.SortBy
 	}
-	return mi.MessageOf(x)
+	return MedicalRecordSortField_MEDICAL_RECORD_SORT_FIELD_RECORD_DATE
 }
 
-// Deprecated: Use ListMedicalRecordsResponse.ProtoReflect.Descriptor instead.
-func (*ListMedicalRecordsResponse) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{16}
+func (x *ListMedicalRecordsRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_DESC
 }
 
-func (x *ListMedicalRecordsResponse) GetMedicalRecords() []*MedicalRecord {
+func (x *ListMedicalRecordsRequest) GetLimit() int64 {
 	if x != nil {
-		return x.MedicalRecords
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-// Turmush tarzi ma'lumotlari uchun message'lar
-type LifestyleData struct {
+func (x *ListMedicalRecordsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListMedicalRecordsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId       string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DataType     string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue    string `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
-	RecordedDate string `protobuf:"bytes,5,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
-	CreatedAt    string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt    string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MedicalRecords []*MedicalRecord `protobuf:"bytes,1,rep,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+	// bo'sh bo'lsa boshqa sahifa yo'q
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// filtrga mos yozuvlarning umumiy soni, cursor ga bog'liq emas
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
-func (x *LifestyleData) Reset() {
-	*x = LifestyleData{}
+func (x *ListMedicalRecordsResponse) Reset() {
+	*x = ListMedicalRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1012,13 +1324,13 @@ func (x *LifestyleData) Reset() {
 	}
 }
 
-func (x *LifestyleData) String() string {
+func (x *ListMedicalRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LifestyleData) ProtoMessage() {}
+func (*ListMedicalRecordsResponse) ProtoMessage() {}
 
-func (x *LifestyleData) ProtoReflect() protoreflect.Message {
+func (x *ListMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1030,73 +1342,44 @@ func (x *LifestyleData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LifestyleData.ProtoReflect.Descriptor instead.
-func (*LifestyleData) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMedicalRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *LifestyleData) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *LifestyleData) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *LifestyleData) GetDataType() string {
-	if x != nil {
-		return x.DataType
-	}
-	return ""
-}
-
-func (x *LifestyleData) GetDataValue() string {
-	if x != nil {
-		return x.DataValue
-	}
-	return ""
-}
-
-func (x *LifestyleData) GetRecordedDate() string {
+func (x *ListMedicalRecordsResponse) GetMedicalRecords() []*MedicalRecord {
 	if x != nil {
-		return x.RecordedDate
+		return x.MedicalRecords
 	}
-	return ""
+	return nil
 }
 
-func (x *LifestyleData) GetCreatedAt() string {
+func (x *ListMedicalRecordsResponse) GetNextCursor() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *LifestyleData) GetUpdatedAt() string {
+func (x *ListMedicalRecordsResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type AddLifestyleDataRequest struct {
+// RestoreMedicalRecord o'chirilgan yozuvni qaytaradi. Yozuv user_id ga tegishli bo'lishi kerak.
+type RestoreMedicalRecordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId       string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DataType     string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue    string `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
-	RecordedDate string `protobuf:"bytes,5,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *AddLifestyleDataRequest) Reset() {
-	*x = AddLifestyleDataRequest{}
+func (x *RestoreMedicalRecordRequest) Reset() {
+	*x = RestoreMedicalRecordRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1104,13 +1387,13 @@ func (x *AddLifestyleDataRequest) Reset() {
 	}
 }
 
-func (x *AddLifestyleDataRequest) String() string {
+func (x *RestoreMedicalRecordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLifestyleDataRequest) ProtoMessage() {}
+func (*RestoreMedicalRecordRequest) ProtoMessage() {}
 
-func (x *AddLifestyleDataRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreMedicalRecordRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1122,49 +1405,35 @@ func (x *AddLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLifestyleDataRequest.ProtoReflect.Descriptor instead.
-func (*AddLifestyleDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*RestoreMedicalRecordRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *AddLifestyleDataRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *AddLifestyleDataRequest) GetDataType() string {
-	if x != nil {
-		return x.DataType
-	}
-	return ""
-}
-
-func (x *AddLifestyleDataRequest) GetDataValue() string {
+func (x *RestoreMedicalRecordRequest) GetId() string {
 	if x != nil {
-		return x.DataValue
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AddLifestyleDataRequest) GetRecordedDate() string {
+func (x *RestoreMedicalRecordRequest) GetUserId() string {
 	if x != nil {
-		return x.RecordedDate
+		return x.UserId
 	}
 	return ""
 }
 
-type AddLifestyleDataResponse struct {
+type RestoreMedicalRecordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LifestyleData *LifestyleData `protobuf:"bytes,1,opt,name=lifestyleData,proto3" json:"lifestyleData,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *AddLifestyleDataResponse) Reset() {
-	*x = AddLifestyleDataResponse{}
+func (x *RestoreMedicalRecordResponse) Reset() {
+	*x = RestoreMedicalRecordResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1172,13 +1441,13 @@ func (x *AddLifestyleDataResponse) Reset() {
 	}
 }
 
-func (x *AddLifestyleDataResponse) String() string {
+func (x *RestoreMedicalRecordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLifestyleDataResponse) ProtoMessage() {}
+func (*RestoreMedicalRecordResponse) ProtoMessage() {}
 
-func (x *AddLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreMedicalRecordResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1190,28 +1459,32 @@ func (x *AddLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLifestyleDataResponse.ProtoReflect.Descriptor instead.
-func (*AddLifestyleDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreMedicalRecordResponse.ProtoReflect.Descriptor instead.
+func (*RestoreMedicalRecordResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AddLifestyleDataResponse) GetLifestyleData() *LifestyleData {
+func (x *RestoreMedicalRecordResponse) GetSuccess() bool {
 	if x != nil {
-		return x.LifestyleData
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetLifestyleDataRequest struct {
+// ListDeletedMedicalRecords foydalanuvchining o'chirilgan yozuvlari, yangi
+// o'chirilganlaridan boshlab
+type ListDeletedMedicalRecordsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetLifestyleDataRequest) Reset() {
-	*x = GetLifestyleDataRequest{}
+func (x *ListDeletedMedicalRecordsRequest) Reset() {
+	*x = ListDeletedMedicalRecordsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1219,13 +1492,13 @@ func (x *GetLifestyleDataRequest) Reset() {
 	}
 }
 
-func (x *GetLifestyleDataRequest) String() string {
+func (x *ListDeletedMedicalRecordsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLifestyleDataRequest) ProtoMessage() {}
+func (*ListDeletedMedicalRecordsRequest) ProtoMessage() {}
 
-func (x *GetLifestyleDataRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDeletedMedicalRecordsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1237,28 +1510,43 @@ func (x *GetLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLifestyleDataRequest.ProtoReflect.Descriptor instead.
-func (*GetLifestyleDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeletedMedicalRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedMedicalRecordsRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetLifestyleDataRequest) GetId() string {
+func (x *ListDeletedMedicalRecordsRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-type GetLifestyleDataResponse struct {
+func (x *ListDeletedMedicalRecordsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletedMedicalRecordsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListDeletedMedicalRecordsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LifestyleData *LifestyleData `protobuf:"bytes,1,opt,name=lifestyle_data,json=lifestyleData,proto3" json:"lifestyle_data,omitempty"`
+	MedicalRecords []*MedicalRecord `protobuf:"bytes,1,rep,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+	NextCursor     string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *GetLifestyleDataResponse) Reset() {
-	*x = GetLifestyleDataResponse{}
+func (x *ListDeletedMedicalRecordsResponse) Reset() {
+	*x = ListDeletedMedicalRecordsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1266,13 +1554,13 @@ func (x *GetLifestyleDataResponse) Reset() {
 	}
 }
 
-func (x *GetLifestyleDataResponse) String() string {
+func (x *ListDeletedMedicalRecordsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLifestyleDataResponse) ProtoMessage() {}
+func (*ListDeletedMedicalRecordsResponse) ProtoMessage() {}
 
-func (x *GetLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDeletedMedicalRecordsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1284,32 +1572,45 @@ func (x *GetLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLifestyleDataResponse.ProtoReflect.Descriptor instead.
-func (*GetLifestyleDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeletedMedicalRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedMedicalRecordsResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetLifestyleDataResponse) GetLifestyleData() *LifestyleData {
+func (x *ListDeletedMedicalRecordsResponse) GetMedicalRecords() []*MedicalRecord {
 	if x != nil {
-		return x.LifestyleData
+		return x.MedicalRecords
 	}
 	return nil
 }
 
-type UpdateLifestyleDataRequest struct {
+func (x *ListDeletedMedicalRecordsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Tibbiy yozuv fayllari uchun message'lar
+//
+// Attachment tibbiy yozuvga biriktirilgan fayl, mazmuni GridFS da saqlanadi
+type Attachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId       string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DataType     string `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue    string `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
-	RecordedDate string `protobuf:"bytes,5,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// fayl mazmunidan aniqlangan tur
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size        int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// hex ko'rinishidagi SHA-256
+	Sha256     string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	UploadedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
 }
 
-func (x *UpdateLifestyleDataRequest) Reset() {
-	*x = UpdateLifestyleDataRequest{}
+func (x *Attachment) Reset() {
+	*x = Attachment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,13 +1618,13 @@ func (x *UpdateLifestyleDataRequest) Reset() {
 	}
 }
 
-func (x *UpdateLifestyleDataRequest) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLifestyleDataRequest) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *UpdateLifestyleDataRequest) ProtoReflect() protoreflect.Message {
+func (x *Attachment) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1335,56 +1636,67 @@ func (x *UpdateLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLifestyleDataRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLifestyleDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateLifestyleDataRequest) GetId() string {
+func (x *Attachment) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateLifestyleDataRequest) GetUserId() string {
+func (x *Attachment) GetFilename() string {
 	if x != nil {
-		return x.UserId
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *UpdateLifestyleDataRequest) GetDataType() string {
+func (x *Attachment) GetContentType() string {
 	if x != nil {
-		return x.DataType
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *UpdateLifestyleDataRequest) GetDataValue() string {
+func (x *Attachment) GetSize() int64 {
 	if x != nil {
-		return x.DataValue
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateLifestyleDataRequest) GetRecordedDate() string {
+func (x *Attachment) GetSha256() string {
 	if x != nil {
-		return x.RecordedDate
+		return x.Sha256
 	}
 	return ""
 }
 
-type UpdateLifestyleDataResponse struct {
+func (x *Attachment) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+// UploadAttachmentRequest birinchi xabarda info, keyingilarida fayl bo'laklari keladi
+type UploadAttachmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Types that are assignable to Data:
+	//	*UploadAttachmentRequest_Info
+	//	*UploadAttachmentRequest_Chunk
+	Data isUploadAttachmentRequest_Data `protobuf_oneof:"data"`
 }
 
-func (x *UpdateLifestyleDataResponse) Reset() {
-	*x = UpdateLifestyleDataResponse{}
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1392,13 +1704,13 @@ func (x *UpdateLifestyleDataResponse) Reset() {
 	}
 }
 
-func (x *UpdateLifestyleDataResponse) String() string {
+func (x *UploadAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLifestyleDataResponse) ProtoMessage() {}
+func (*UploadAttachmentRequest) ProtoMessage() {}
 
-func (x *UpdateLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1410,28 +1722,61 @@ func (x *UpdateLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLifestyleDataResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLifestyleDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateLifestyleDataResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
+func (m *UploadAttachmentRequest) GetData() isUploadAttachmentRequest_Data {
+	if m != nil {
+		return m.Data
 	}
-	return false
+	return nil
 }
 
-type DeleteLifestyleDataRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *UploadAttachmentRequest) GetInfo() *AttachmentUploadInfo {
+	if x, ok := x.GetData().(*UploadAttachmentRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x, ok := x.GetData().(*UploadAttachmentRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
 }
 
-func (x *DeleteLifestyleDataRequest) Reset() {
-	*x = DeleteLifestyleDataRequest{}
+type isUploadAttachmentRequest_Data interface {
+	isUploadAttachmentRequest_Data()
+}
+
+type UploadAttachmentRequest_Info struct {
+	Info *AttachmentUploadInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Info) isUploadAttachmentRequest_Data() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Data() {}
+
+type AttachmentUploadInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordId string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	// ixtiyoriy, berilsa yuklangan fayl checksum i bilan solishtiriladi
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *AttachmentUploadInfo) Reset() {
+	*x = AttachmentUploadInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1439,13 +1784,13 @@ func (x *DeleteLifestyleDataRequest) Reset() {
 	}
 }
 
-func (x *DeleteLifestyleDataRequest) String() string {
+func (x *AttachmentUploadInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLifestyleDataRequest) ProtoMessage() {}
+func (*AttachmentUploadInfo) ProtoMessage() {}
 
-func (x *DeleteLifestyleDataRequest) ProtoReflect() protoreflect.Message {
+func (x *AttachmentUploadInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1457,28 +1802,42 @@ func (x *DeleteLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLifestyleDataRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLifestyleDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AttachmentUploadInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentUploadInfo) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeleteLifestyleDataRequest) GetId() string {
+func (x *AttachmentUploadInfo) GetRecordId() string {
 	if x != nil {
-		return x.Id
+		return x.RecordId
 	}
 	return ""
 }
 
-type DeleteLifestyleDataResponse struct {
+func (x *AttachmentUploadInfo) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AttachmentUploadInfo) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type UploadAttachmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
 }
 
-func (x *DeleteLifestyleDataResponse) Reset() {
-	*x = DeleteLifestyleDataResponse{}
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1486,13 +1845,13 @@ func (x *DeleteLifestyleDataResponse) Reset() {
 	}
 }
 
-func (x *DeleteLifestyleDataResponse) String() string {
+func (x *UploadAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLifestyleDataResponse) ProtoMessage() {}
+func (*UploadAttachmentResponse) ProtoMessage() {}
 
-func (x *DeleteLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1504,36 +1863,29 @@ func (x *DeleteLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLifestyleDataResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLifestyleDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DeleteLifestyleDataResponse) GetSuccess() bool {
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
 	if x != nil {
-		return x.Success
+		return x.Attachment
 	}
-	return false
+	return nil
 }
 
-// Kiyiladigan qurilma ma'lumotlari uchun message'lar
-type WearableData struct {
+type DownloadAttachmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId            string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeviceType        string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	DataType          string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue         string `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
-	RecordedTimestamp string `protobuf:"bytes,6,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
-	CreatedAt         string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt         string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RecordId     string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	AttachmentId string `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
 }
 
-func (x *WearableData) Reset() {
-	*x = WearableData{}
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1541,13 +1893,13 @@ func (x *WearableData) Reset() {
 	}
 }
 
-func (x *WearableData) String() string {
+func (x *DownloadAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WearableData) ProtoMessage() {}
+func (*DownloadAttachmentRequest) ProtoMessage() {}
 
-func (x *WearableData) ProtoReflect() protoreflect.Message {
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1559,81 +1911,39 @@ func (x *WearableData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WearableData.ProtoReflect.Descriptor instead.
-func (*WearableData) Descriptor() ([]byte, []int) {
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *WearableData) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *WearableData) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *WearableData) GetDeviceType() string {
-	if x != nil {
-		return x.DeviceType
-	}
-	return ""
-}
-
-func (x *WearableData) GetDataType() string {
-	if x != nil {
-		return x.DataType
-	}
-	return ""
-}
-
-func (x *WearableData) GetDataValue() string {
-	if x != nil {
-		return x.DataValue
-	}
-	return ""
-}
-
-func (x *WearableData) GetRecordedTimestamp() string {
+func (x *DownloadAttachmentRequest) GetRecordId() string {
 	if x != nil {
-		return x.RecordedTimestamp
-	}
-	return ""
-}
-
-func (x *WearableData) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
+		return x.RecordId
 	}
 	return ""
 }
 
-func (x *WearableData) GetUpdatedAt() string {
+func (x *DownloadAttachmentRequest) GetAttachmentId() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.AttachmentId
 	}
 	return ""
 }
 
-type AddWearableDataRequest struct {
+// DownloadAttachmentResponse birinchi xabarda attachment, keyingilarida fayl bo'laklari keladi
+type DownloadAttachmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId            string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeviceType        string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	DataType          string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue         string `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
-	RecordedTimestamp string `protobuf:"bytes,6,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
+	// Types that are assignable to Data:
+	//	*DownloadAttachmentResponse_Attachment
+	//	*DownloadAttachmentResponse_Chunk
+	Data isDownloadAttachmentResponse_Data `protobuf_oneof:"data"`
 }
 
-func (x *AddWearableDataRequest) Reset() {
-	*x = AddWearableDataRequest{}
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1641,13 +1951,13 @@ func (x *AddWearableDataRequest) Reset() {
 	}
 }
 
-func (x *AddWearableDataRequest) String() string {
+func (x *DownloadAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddWearableDataRequest) ProtoMessage() {}
+func (*DownloadAttachmentResponse) ProtoMessage() {}
 
-func (x *AddWearableDataRequest) ProtoReflect() protoreflect.Message {
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1659,56 +1969,59 @@ func (x *AddWearableDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddWearableDataRequest.ProtoReflect.Descriptor instead.
-func (*AddWearableDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AddWearableDataRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
+func (m *DownloadAttachmentResponse) GetData() isDownloadAttachmentResponse_Data {
+	if m != nil {
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *AddWearableDataRequest) GetDeviceType() string {
-	if x != nil {
-		return x.DeviceType
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x, ok := x.GetData().(*DownloadAttachmentResponse_Attachment); ok {
+		return x.Attachment
 	}
-	return ""
+	return nil
 }
 
-func (x *AddWearableDataRequest) GetDataType() string {
-	if x != nil {
-		return x.DataType
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x, ok := x.GetData().(*DownloadAttachmentResponse_Chunk); ok {
+		return x.Chunk
 	}
-	return ""
+	return nil
 }
 
-func (x *AddWearableDataRequest) GetDataValue() string {
-	if x != nil {
-		return x.DataValue
-	}
-	return ""
+type isDownloadAttachmentResponse_Data interface {
+	isDownloadAttachmentResponse_Data()
 }
 
-func (x *AddWearableDataRequest) GetRecordedTimestamp() string {
-	if x != nil {
-		return x.RecordedTimestamp
-	}
-	return ""
+type DownloadAttachmentResponse_Attachment struct {
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3,oneof"`
 }
 
-type AddWearableDataResponse struct {
+type DownloadAttachmentResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadAttachmentResponse_Attachment) isDownloadAttachmentResponse_Data() {}
+
+func (*DownloadAttachmentResponse_Chunk) isDownloadAttachmentResponse_Data() {}
+
+type DeleteAttachmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WearableData *WearableData `protobuf:"bytes,1,opt,name=wearableData,proto3" json:"wearableData,omitempty"`
+	RecordId     string `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3" json:"record_id,omitempty"`
+	AttachmentId string `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
 }
 
-func (x *AddWearableDataResponse) Reset() {
-	*x = AddWearableDataResponse{}
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1716,13 +2029,13 @@ func (x *AddWearableDataResponse) Reset() {
 	}
 }
 
-func (x *AddWearableDataResponse) String() string {
+func (x *DeleteAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddWearableDataResponse) ProtoMessage() {}
+func (*DeleteAttachmentRequest) ProtoMessage() {}
 
-func (x *AddWearableDataResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1734,28 +2047,35 @@ func (x *AddWearableDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddWearableDataResponse.ProtoReflect.Descriptor instead.
-func (*AddWearableDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *AddWearableDataResponse) GetWearableData() *WearableData {
+func (x *DeleteAttachmentRequest) GetRecordId() string {
 	if x != nil {
-		return x.WearableData
+		return x.RecordId
 	}
-	return nil
+	return ""
 }
 
-type GetWearableDataRequest struct {
+func (x *DeleteAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+type DeleteAttachmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *GetWearableDataRequest) Reset() {
-	*x = GetWearableDataRequest{}
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1763,13 +2083,13 @@ func (x *GetWearableDataRequest) Reset() {
 	}
 }
 
-func (x *GetWearableDataRequest) String() string {
+func (x *DeleteAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWearableDataRequest) ProtoMessage() {}
+func (*DeleteAttachmentResponse) ProtoMessage() {}
 
-func (x *GetWearableDataRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1781,28 +2101,38 @@ func (x *GetWearableDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWearableDataRequest.ProtoReflect.Descriptor instead.
-func (*GetWearableDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetWearableDataRequest) GetId() string {
+func (x *DeleteAttachmentResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GetWearableDataResponse struct {
+// Turmush tarzi ma'lumotlari uchun message'lar
+type LifestyleData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WearableData *WearableData `protobuf:"bytes,1,opt,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType     string                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DataValue    string                 `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
+	RecordedDate *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Measurement  *Measurement           `protobuf:"bytes,8,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	// faqat o'chirilgan yozuvlar ro'yxatida to'ldiriladi
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 }
 
-func (x *GetWearableDataResponse) Reset() {
-	*x = GetWearableDataResponse{}
+func (x *LifestyleData) Reset() {
+	*x = LifestyleData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1810,13 +2140,13 @@ func (x *GetWearableDataResponse) Reset() {
 	}
 }
 
-func (x *GetWearableDataResponse) String() string {
+func (x *LifestyleData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWearableDataResponse) ProtoMessage() {}
+func (*LifestyleData) ProtoMessage() {}
 
-func (x *GetWearableDataResponse) ProtoReflect() protoreflect.Message {
+func (x *LifestyleData) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1828,33 +2158,89 @@ func (x *GetWearableDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWearableDataResponse.ProtoReflect.Descriptor instead.
-func (*GetWearableDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LifestyleData.ProtoReflect.Descriptor instead.
+func (*LifestyleData) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetWearableDataResponse) GetWearableData() *WearableData {
+func (x *LifestyleData) GetId() string {
 	if x != nil {
-		return x.WearableData
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LifestyleData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LifestyleData) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *LifestyleData) GetDataValue() string {
+	if x != nil {
+		return x.DataValue
+	}
+	return ""
+}
+
+func (x *LifestyleData) GetRecordedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedDate
 	}
 	return nil
 }
 
-type UpdateWearableDataRequest struct {
+func (x *LifestyleData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LifestyleData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LifestyleData) GetMeasurement() *Measurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+func (x *LifestyleData) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type AddLifestyleDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId            string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeviceType        string `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
-	DataType          string `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	DataValue         string `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
-	RecordedTimestamp string `protobuf:"bytes,6,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType     string                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DataValue    string                 `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
+	RecordedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
+	// berilmasa data_value dan ajratib olinadi
+	Measurement *Measurement `protobuf:"bytes,6,opt,name=measurement,proto3" json:"measurement,omitempty"`
 }
 
-func (x *UpdateWearableDataRequest) Reset() {
-	*x = UpdateWearableDataRequest{}
+func (x *AddLifestyleDataRequest) Reset() {
+	*x = AddLifestyleDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1862,13 +2248,13 @@ func (x *UpdateWearableDataRequest) Reset() {
 	}
 }
 
-func (x *UpdateWearableDataRequest) String() string {
+func (x *AddLifestyleDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWearableDataRequest) ProtoMessage() {}
+func (*AddLifestyleDataRequest) ProtoMessage() {}
 
-func (x *UpdateWearableDataRequest) ProtoReflect() protoreflect.Message {
+func (x *AddLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1880,63 +2266,56 @@ func (x *UpdateWearableDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWearableDataRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWearableDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddLifestyleDataRequest.ProtoReflect.Descriptor instead.
+func (*AddLifestyleDataRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateWearableDataRequest) GetId() string {
+func (x *AddLifestyleDataRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateWearableDataRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UpdateWearableDataRequest) GetDeviceType() string {
+func (x *AddLifestyleDataRequest) GetDataType() string {
 	if x != nil {
-		return x.DeviceType
+		return x.DataType
 	}
 	return ""
 }
 
-func (x *UpdateWearableDataRequest) GetDataType() string {
+func (x *AddLifestyleDataRequest) GetDataValue() string {
 	if x != nil {
-		return x.DataType
+		return x.DataValue
 	}
 	return ""
 }
 
-func (x *UpdateWearableDataRequest) GetDataValue() string {
+func (x *AddLifestyleDataRequest) GetRecordedDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DataValue
+		return x.RecordedDate
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateWearableDataRequest) GetRecordedTimestamp() string {
+func (x *AddLifestyleDataRequest) GetMeasurement() *Measurement {
 	if x != nil {
-		return x.RecordedTimestamp
+		return x.Measurement
 	}
-	return ""
+	return nil
 }
 
-type UpdateWearableDataResponse struct {
+type AddLifestyleDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	LifestyleData *LifestyleData `protobuf:"bytes,1,opt,name=lifestyleData,proto3" json:"lifestyleData,omitempty"`
 }
 
-func (x *UpdateWearableDataResponse) Reset() {
-	*x = UpdateWearableDataResponse{}
+func (x *AddLifestyleDataResponse) Reset() {
+	*x = AddLifestyleDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1944,13 +2323,13 @@ func (x *UpdateWearableDataResponse) Reset() {
 	}
 }
 
-func (x *UpdateWearableDataResponse) String() string {
+func (x *AddLifestyleDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWearableDataResponse) ProtoMessage() {}
+func (*AddLifestyleDataResponse) ProtoMessage() {}
 
-func (x *UpdateWearableDataResponse) ProtoReflect() protoreflect.Message {
+func (x *AddLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1962,19 +2341,19 @@ func (x *UpdateWearableDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWearableDataResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWearableDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddLifestyleDataResponse.ProtoReflect.Descriptor instead.
+func (*AddLifestyleDataResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateWearableDataResponse) GetSuccess() bool {
+func (x *AddLifestyleDataResponse) GetLifestyleData() *LifestyleData {
 	if x != nil {
-		return x.Success
+		return x.LifestyleData
 	}
-	return false
+	return nil
 }
 
-type DeleteWearableDataRequest struct {
+type GetLifestyleDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1982,8 +2361,8 @@ type DeleteWearableDataRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteWearableDataRequest) Reset() {
-	*x = DeleteWearableDataRequest{}
+func (x *GetLifestyleDataRequest) Reset() {
+	*x = GetLifestyleDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1991,13 +2370,13 @@ func (x *DeleteWearableDataRequest) Reset() {
 	}
 }
 
-func (x *DeleteWearableDataRequest) String() string {
+func (x *GetLifestyleDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWearableDataRequest) ProtoMessage() {}
+func (*GetLifestyleDataRequest) ProtoMessage() {}
 
-func (x *DeleteWearableDataRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2009,28 +2388,28 @@ func (x *DeleteWearableDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWearableDataRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWearableDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLifestyleDataRequest.ProtoReflect.Descriptor instead.
+func (*GetLifestyleDataRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DeleteWearableDataRequest) GetId() string {
+func (x *GetLifestyleDataRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteWearableDataResponse struct {
+type GetLifestyleDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	LifestyleData *LifestyleData `protobuf:"bytes,1,opt,name=lifestyle_data,json=lifestyleData,proto3" json:"lifestyle_data,omitempty"`
 }
 
-func (x *DeleteWearableDataResponse) Reset() {
-	*x = DeleteWearableDataResponse{}
+func (x *GetLifestyleDataResponse) Reset() {
+	*x = GetLifestyleDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,13 +2417,13 @@ func (x *DeleteWearableDataResponse) Reset() {
 	}
 }
 
-func (x *DeleteWearableDataResponse) String() string {
+func (x *GetLifestyleDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWearableDataResponse) ProtoMessage() {}
+func (*GetLifestyleDataResponse) ProtoMessage() {}
 
-func (x *DeleteWearableDataResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2056,35 +2435,34 @@ func (x *DeleteWearableDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWearableDataResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWearableDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLifestyleDataResponse.ProtoReflect.Descriptor instead.
+func (*GetLifestyleDataResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DeleteWearableDataResponse) GetSuccess() bool {
+func (x *GetLifestyleDataResponse) GetLifestyleData() *LifestyleData {
 	if x != nil {
-		return x.Success
+		return x.LifestyleData
 	}
-	return false
+	return nil
 }
 
-// Sog'liq tavsiyalari va monitoringi uchun message'lar
-type HealthRecommendation struct {
+type UpdateLifestyleDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId             string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RecommendationType string `protobuf:"bytes,3,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
-	Description        string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Priority           int32  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
-	CreatedAt          string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType     string                 `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DataValue    string                 `protobuf:"bytes,4,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
+	RecordedDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=recorded_date,json=recordedDate,proto3" json:"recorded_date,omitempty"`
+	// berilmasa data_value dan ajratib olinadi
+	Measurement *Measurement `protobuf:"bytes,6,opt,name=measurement,proto3" json:"measurement,omitempty"`
 }
 
-func (x *HealthRecommendation) Reset() {
-	*x = HealthRecommendation{}
+func (x *UpdateLifestyleDataRequest) Reset() {
+	*x = UpdateLifestyleDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2092,13 +2470,13 @@ func (x *HealthRecommendation) Reset() {
 	}
 }
 
-func (x *HealthRecommendation) String() string {
+func (x *UpdateLifestyleDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthRecommendation) ProtoMessage() {}
+func (*UpdateLifestyleDataRequest) ProtoMessage() {}
 
-func (x *HealthRecommendation) ProtoReflect() protoreflect.Message {
+func (x *UpdateLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2110,73 +2488,63 @@ func (x *HealthRecommendation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthRecommendation.ProtoReflect.Descriptor instead.
-func (*HealthRecommendation) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLifestyleDataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLifestyleDataRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *HealthRecommendation) GetId() string {
+func (x *UpdateLifestyleDataRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *HealthRecommendation) GetUserId() string {
+func (x *UpdateLifestyleDataRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *HealthRecommendation) GetRecommendationType() string {
+func (x *UpdateLifestyleDataRequest) GetDataType() string {
 	if x != nil {
-		return x.RecommendationType
+		return x.DataType
 	}
 	return ""
 }
 
-func (x *HealthRecommendation) GetDescription() string {
+func (x *UpdateLifestyleDataRequest) GetDataValue() string {
 	if x != nil {
-		return x.Description
+		return x.DataValue
 	}
 	return ""
 }
 
-func (x *HealthRecommendation) GetPriority() int32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-func (x *HealthRecommendation) GetCreatedAt() string {
+func (x *UpdateLifestyleDataRequest) GetRecordedDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.RecordedDate
 	}
-	return ""
+	return nil
 }
 
-func (x *HealthRecommendation) GetUpdatedAt() string {
+func (x *UpdateLifestyleDataRequest) GetMeasurement() *Measurement {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Measurement
 	}
-	return ""
+	return nil
 }
 
-type GenerateHealthRecommendationsRequest struct {
+type UpdateLifestyleDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId             string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	RecommendationType string `protobuf:"bytes,2,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
-	Description        string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Priority           int32  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *GenerateHealthRecommendationsRequest) Reset() {
-	*x = GenerateHealthRecommendationsRequest{}
+func (x *UpdateLifestyleDataResponse) Reset() {
+	*x = UpdateLifestyleDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2184,13 +2552,13 @@ func (x *GenerateHealthRecommendationsRequest) Reset() {
 	}
 }
 
-func (x *GenerateHealthRecommendationsRequest) String() string {
+func (x *UpdateLifestyleDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateHealthRecommendationsRequest) ProtoMessage() {}
+func (*UpdateLifestyleDataResponse) ProtoMessage() {}
 
-func (x *GenerateHealthRecommendationsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2202,49 +2570,28 @@ func (x *GenerateHealthRecommendationsRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateHealthRecommendationsRequest.ProtoReflect.Descriptor instead.
-func (*GenerateHealthRecommendationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLifestyleDataResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLifestyleDataResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GenerateHealthRecommendationsRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GenerateHealthRecommendationsRequest) GetRecommendationType() string {
-	if x != nil {
-		return x.RecommendationType
-	}
-	return ""
-}
-
-func (x *GenerateHealthRecommendationsRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *GenerateHealthRecommendationsRequest) GetPriority() int32 {
+func (x *UpdateLifestyleDataResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Priority
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type GenerateHealthRecommendationsResponse struct {
+type DeleteLifestyleDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recommendations *HealthRecommendation `protobuf:"bytes,1,opt,name=recommendations,proto3" json:"recommendations,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GenerateHealthRecommendationsResponse) Reset() {
-	*x = GenerateHealthRecommendationsResponse{}
+func (x *DeleteLifestyleDataRequest) Reset() {
+	*x = DeleteLifestyleDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2252,13 +2599,13 @@ func (x *GenerateHealthRecommendationsResponse) Reset() {
 	}
 }
 
-func (x *GenerateHealthRecommendationsResponse) String() string {
+func (x *DeleteLifestyleDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateHealthRecommendationsResponse) ProtoMessage() {}
+func (*DeleteLifestyleDataRequest) ProtoMessage() {}
 
-func (x *GenerateHealthRecommendationsResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2270,28 +2617,28 @@ func (x *GenerateHealthRecommendationsResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateHealthRecommendationsResponse.ProtoReflect.Descriptor instead.
-func (*GenerateHealthRecommendationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteLifestyleDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLifestyleDataRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GenerateHealthRecommendationsResponse) GetRecommendations() *HealthRecommendation {
+func (x *DeleteLifestyleDataRequest) GetId() string {
 	if x != nil {
-		return x.Recommendations
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetRealtimeHealthMonitoringRequest struct {
+type DeleteLifestyleDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *GetRealtimeHealthMonitoringRequest) Reset() {
-	*x = GetRealtimeHealthMonitoringRequest{}
+func (x *DeleteLifestyleDataResponse) Reset() {
+	*x = DeleteLifestyleDataResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2299,13 +2646,13 @@ func (x *GetRealtimeHealthMonitoringRequest) Reset() {
 	}
 }
 
-func (x *GetRealtimeHealthMonitoringRequest) String() string {
+func (x *DeleteLifestyleDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRealtimeHealthMonitoringRequest) ProtoMessage() {}
+func (*DeleteLifestyleDataResponse) ProtoMessage() {}
 
-func (x *GetRealtimeHealthMonitoringRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteLifestyleDataResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2317,32 +2664,30 @@ func (x *GetRealtimeHealthMonitoringRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRealtimeHealthMonitoringRequest.ProtoReflect.Descriptor instead.
-func (*GetRealtimeHealthMonitoringRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteLifestyleDataResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLifestyleDataResponse) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetRealtimeHealthMonitoringRequest) GetUserId() string {
+func (x *DeleteLifestyleDataResponse) GetSuccess() bool {
 	if x != nil {
-		return x.UserId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GetRealtimeHealthMonitoringResponse struct {
+// RestoreLifestyleData o'chirilgan yozuvni qaytaradi. Yozuv user_id ga tegishli bo'lishi kerak.
+type RestoreLifestyleDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FirstName          string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName           string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	RecommendationType string `protobuf:"bytes,3,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
-	Description        string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Priority           int32  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetRealtimeHealthMonitoringResponse) Reset() {
-	*x = GetRealtimeHealthMonitoringResponse{}
+func (x *RestoreLifestyleDataRequest) Reset() {
+	*x = RestoreLifestyleDataRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2350,13 +2695,13 @@ func (x *GetRealtimeHealthMonitoringResponse) Reset() {
 	}
 }
 
-func (x *GetRealtimeHealthMonitoringResponse) String() string {
+func (x *RestoreLifestyleDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRealtimeHealthMonitoringResponse) ProtoMessage() {}
+func (*RestoreLifestyleDataRequest) ProtoMessage() {}
 
-func (x *GetRealtimeHealthMonitoringResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreLifestyleDataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2368,72 +2713,101 @@ func (x *GetRealtimeHealthMonitoringResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRealtimeHealthMonitoringResponse.ProtoReflect.Descriptor instead.
-func (*GetRealtimeHealthMonitoringResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreLifestyleDataRequest.ProtoReflect.Descriptor instead.
+func (*RestoreLifestyleDataRequest) Descriptor() ([]byte, []int) {
 	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetRealtimeHealthMonitoringResponse) GetFirstName() string {
+func (x *RestoreLifestyleDataRequest) GetId() string {
 	if x != nil {
-		return x.FirstName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetRealtimeHealthMonitoringResponse) GetLastName() string {
+func (x *RestoreLifestyleDataRequest) GetUserId() string {
 	if x != nil {
-		return x.LastName
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetRealtimeHealthMonitoringResponse) GetRecommendationType() string {
-	if x != nil {
-		return x.RecommendationType
-	}
-	return ""
+type RestoreLifestyleDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *GetRealtimeHealthMonitoringResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *RestoreLifestyleDataResponse) Reset() {
+	*x = RestoreLifestyleDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *GetRealtimeHealthMonitoringResponse) GetPriority() int32 {
+func (x *RestoreLifestyleDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreLifestyleDataResponse) ProtoMessage() {}
+
+func (x *RestoreLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreLifestyleDataResponse.ProtoReflect.Descriptor instead.
+func (*RestoreLifestyleDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestoreLifestyleDataResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Priority
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type GetDailyHealthSummaryRequest struct {
+// ListDeletedLifestyleData foydalanuvchining o'chirilgan yozuvlari, yangi
+// o'chirilganlaridan boshlab
+type ListDeletedLifestyleDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Date   string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
-func (x *GetDailyHealthSummaryRequest) Reset() {
-	*x = GetDailyHealthSummaryRequest{}
+func (x *ListDeletedLifestyleDataRequest) Reset() {
+	*x = ListDeletedLifestyleDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[40]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDailyHealthSummaryRequest) String() string {
+func (x *ListDeletedLifestyleDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDailyHealthSummaryRequest) ProtoMessage() {}
+func (*ListDeletedLifestyleDataRequest) ProtoMessage() {}
 
-func (x *GetDailyHealthSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[40]
+func (x *ListDeletedLifestyleDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,54 +2818,58 @@ func (x *GetDailyHealthSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDailyHealthSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetDailyHealthSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ListDeletedLifestyleDataRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedLifestyleDataRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetDailyHealthSummaryRequest) GetUserId() string {
+func (x *ListDeletedLifestyleDataRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetDailyHealthSummaryRequest) GetDate() string {
+func (x *ListDeletedLifestyleDataRequest) GetLimit() int64 {
 	if x != nil {
-		return x.Date
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletedLifestyleDataRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
 	}
 	return ""
 }
 
-type GetDailyHealthSummaryResponse struct {
+type ListDeletedLifestyleDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FirstName          string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName           string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	RecommendationType string `protobuf:"bytes,3,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
-	Description        string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Priority           int32  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	LifestyleData []*LifestyleData `protobuf:"bytes,1,rep,name=lifestyle_data,json=lifestyleData,proto3" json:"lifestyle_data,omitempty"`
+	NextCursor    string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *GetDailyHealthSummaryResponse) Reset() {
-	*x = GetDailyHealthSummaryResponse{}
+func (x *ListDeletedLifestyleDataResponse) Reset() {
+	*x = ListDeletedLifestyleDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[41]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDailyHealthSummaryResponse) String() string {
+func (x *ListDeletedLifestyleDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDailyHealthSummaryResponse) ProtoMessage() {}
+func (*ListDeletedLifestyleDataResponse) ProtoMessage() {}
 
-func (x *GetDailyHealthSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[41]
+func (x *ListDeletedLifestyleDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2502,72 +2880,177 @@ func (x *GetDailyHealthSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDailyHealthSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetDailyHealthSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ListDeletedLifestyleDataResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedLifestyleDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetDailyHealthSummaryResponse) GetFirstName() string {
+func (x *ListDeletedLifestyleDataResponse) GetLifestyleData() []*LifestyleData {
 	if x != nil {
-		return x.FirstName
+		return x.LifestyleData
+	}
+	return nil
+}
+
+func (x *ListDeletedLifestyleDataResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *GetDailyHealthSummaryResponse) GetLastName() string {
+// Kiyiladigan qurilma ma'lumotlari uchun message'lar
+type WearableData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceType        string                 `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	DataType          string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DataValue         string                 `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
+	RecordedTimestamp *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Measurement       *Measurement           `protobuf:"bytes,9,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	// faqat o'chirilgan yozuvlar ro'yxatida to'ldiriladi
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *WearableData) Reset() {
+	*x = WearableData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WearableData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WearableData) ProtoMessage() {}
+
+func (x *WearableData) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WearableData.ProtoReflect.Descriptor instead.
+func (*WearableData) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WearableData) GetId() string {
 	if x != nil {
-		return x.LastName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetDailyHealthSummaryResponse) GetRecommendationType() string {
+func (x *WearableData) GetUserId() string {
 	if x != nil {
-		return x.RecommendationType
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetDailyHealthSummaryResponse) GetDescription() string {
+func (x *WearableData) GetDeviceType() string {
 	if x != nil {
-		return x.Description
+		return x.DeviceType
 	}
 	return ""
 }
 
-func (x *GetDailyHealthSummaryResponse) GetPriority() int32 {
+func (x *WearableData) GetDataType() string {
 	if x != nil {
-		return x.Priority
+		return x.DataType
 	}
-	return 0
+	return ""
 }
 
-type GetWeeklyHealthSummaryRequest struct {
+func (x *WearableData) GetDataValue() string {
+	if x != nil {
+		return x.DataValue
+	}
+	return ""
+}
+
+func (x *WearableData) GetRecordedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedTimestamp
+	}
+	return nil
+}
+
+func (x *WearableData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WearableData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WearableData) GetMeasurement() *Measurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+func (x *WearableData) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type AddWearableDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceType        string                 `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	DataType          string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DataValue         string                 `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
+	RecordedTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
+	// berilmasa data_value dan ajratib olinadi
+	Measurement *Measurement `protobuf:"bytes,7,opt,name=measurement,proto3" json:"measurement,omitempty"`
 }
 
-func (x *GetWeeklyHealthSummaryRequest) Reset() {
-	*x = GetWeeklyHealthSummaryRequest{}
+func (x *AddWearableDataRequest) Reset() {
+	*x = AddWearableDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[42]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWeeklyHealthSummaryRequest) String() string {
+func (x *AddWearableDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWeeklyHealthSummaryRequest) ProtoMessage() {}
+func (*AddWearableDataRequest) ProtoMessage() {}
 
-func (x *GetWeeklyHealthSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[42]
+func (x *AddWearableDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2578,50 +3061,78 @@ func (x *GetWeeklyHealthSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWeeklyHealthSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetWeeklyHealthSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use AddWearableDataRequest.ProtoReflect.Descriptor instead.
+func (*AddWearableDataRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetWeeklyHealthSummaryRequest) GetUserId() string {
+func (x *AddWearableDataRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetWeeklyHealthSummaryRequest) GetStartDate() string {
+func (x *AddWearableDataRequest) GetDeviceType() string {
 	if x != nil {
-		return x.StartDate
+		return x.DeviceType
 	}
 	return ""
 }
 
-type GetWeeklyHealthSummaryResponse struct {
+func (x *AddWearableDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *AddWearableDataRequest) GetDataValue() string {
+	if x != nil {
+		return x.DataValue
+	}
+	return ""
+}
+
+func (x *AddWearableDataRequest) GetRecordedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedTimestamp
+	}
+	return nil
+}
+
+func (x *AddWearableDataRequest) GetMeasurement() *Measurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+type AddWearableDataResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Health []*HealthRecommendation `protobuf:"bytes,1,rep,name=health,proto3" json:"health,omitempty"`
+	WearableData *WearableData `protobuf:"bytes,1,opt,name=wearableData,proto3" json:"wearableData,omitempty"`
 }
 
-func (x *GetWeeklyHealthSummaryResponse) Reset() {
-	*x = GetWeeklyHealthSummaryResponse{}
+func (x *AddWearableDataResponse) Reset() {
+	*x = AddWearableDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[43]
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWeeklyHealthSummaryResponse) String() string {
+func (x *AddWearableDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWeeklyHealthSummaryResponse) ProtoMessage() {}
+func (*AddWearableDataResponse) ProtoMessage() {}
 
-func (x *GetWeeklyHealthSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[43]
+func (x *AddWearableDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2632,632 +3143,6700 @@ func (x *GetWeeklyHealthSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWeeklyHealthSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetWeeklyHealthSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use AddWearableDataResponse.ProtoReflect.Descriptor instead.
+func (*AddWearableDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetWeeklyHealthSummaryResponse) GetHealth() []*HealthRecommendation {
+func (x *AddWearableDataResponse) GetWearableData() *WearableData {
 	if x != nil {
-		return x.Health
+		return x.WearableData
 	}
 	return nil
 }
 
-var File_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto protoreflect.FileDescriptor
+// AddWearableDataBatch bir nechta o'lchovni bitta so'rovda navbatga yozadi.
+// Biror o'lchov noto'g'ri bo'lsa hech biri yozilmaydi.
+type AddWearableDataBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDesc = []byte{
-	0x0a, 0x41, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x5f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x22, 0x7a, 0x0a, 0x27, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4f, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0x38, 0x0a, 0x26, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x1a, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x72,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77,
-	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x61, 0x74, 0x61, 0x22, 0x45, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x22, 0x63, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x44, 0x0a, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73,
-	0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74,
-	0x79, 0x6c, 0x65, 0x64, 0x61, 0x74, 0x61, 0x22, 0x46, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22,
-	0x99, 0x02, 0x0a, 0x0d, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x17,
-	0x41, 0x64, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0x61, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
-	0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
-	0x37, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0x34, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d,
-	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e, 0x6d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0xd7, 0x01, 0x0a,
-	0x0d, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x4c, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61,
-	0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x60, 0x0a, 0x18,
-	0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x6c, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x29,
-	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
-	0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xa6, 0x01, 0x0a,
-	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x37, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2c,
-	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x1b,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2d, 0x0a, 0x12,
-	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x16, 0x41, 0x64,
-	0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
-	0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5c, 0x0a, 0x17, 0x41, 0x64, 0x64,
-	0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x57, 0x65, 0x61,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x57, 0x65,
-	0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d,
-	0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0xd0, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61,
-	0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74,
-	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0x36, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x22, 0xec, 0x01, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0xae, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x22, 0x78, 0x0a, 0x25, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x72, 0x65, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x22, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x23, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a,
-	0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x4b, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x1d, 0x47, 0x65,
-	0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d,
-	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
-	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72,
-	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x57, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65,
-	0x6b, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22,
-	0x5f, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x32, 0x9f, 0x13, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x41,
-	0x64, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4d,
-	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63,
-	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
-	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
-	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64,
-	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x70, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69,
-	0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x67, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74,
-	0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x57,
-	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x2e, 0x68, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
-	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x27, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
-	0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65,
-	0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57,
-	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
-	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65,
-	0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72,
-	0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
-	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61,
-	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x35, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65,
-	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x37, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x38, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1b, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x2e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
-	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x2e,
-	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d,
-	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b,
-	0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
-	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b,
-	0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x33, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61,
-	0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x1b, 0x5a, 0x19, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Readings []*AddWearableDataRequest `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
 }
 
-var (
-	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescOnce sync.Once
-	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData = file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDesc
-)
+func (x *AddWearableDataBatchRequest) Reset() {
+	*x = AddWearableDataBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP() []byte {
-	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescOnce.Do(func() {
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData)
-	})
-	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData
+func (x *AddWearableDataBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
-var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_goTypes = []any{
-	(*GenerateHealthRecommendationsIdResponse)(nil), // 0: healthanalytics.GenerateHealthRecommendationsIdResponse
-	(*GenerateHealthRecommendationsIdRequest)(nil),  // 1: healthanalytics.GenerateHealthRecommendationsIdRequest
-	(*GetAllWearableDataResponse)(nil),              // 2: healthanalytics.GetAllWearableDataResponse
-	(*GetAllWearableDataRequest)(nil),               // 3: healthanalytics.GetAllWearableDataRequest
-	(*GetAllLifestyleDataResponse)(nil),             // 4: healthanalytics.GetAllLifestyleDataResponse
-	(*GetAllLifestyleDataRequest)(nil),              // 5: healthanalytics.GetAllLifestyleDataRequest
-	(*MedicalRecord)(nil),                           // 6: healthanalytics.MedicalRecord
-	(*AddMedicalRecordRequest)(nil),                 // 7: healthanalytics.AddMedicalRecordRequest
-	(*AddMedicalRecordResponse)(nil),                // 8: healthanalytics.AddMedicalRecordResponse
-	(*GetMedicalRecordRequest)(nil),                 // 9: healthanalytics.GetMedicalRecordRequest
-	(*GetMedicalRecordResponse)(nil),                // 10: healthanalytics.GetMedicalRecordResponse
-	(*UpdateMedicalRecordRequest)(nil),              // 11: healthanalytics.UpdateMedicalRecordRequest
-	(*UpdateMedicalRecordResponse)(nil),             // 12: healthanalytics.UpdateMedicalRecordResponse
-	(*DeleteMedicalRecordRequest)(nil),              // 13: healthanalytics.DeleteMedicalRecordRequest
-	(*DeleteMedicalRecordResponse)(nil),             // 14: healthanalytics.DeleteMedicalRecordResponse
-	(*ListMedicalRecordsRequest)(nil),               // 15: healthanalytics.ListMedicalRecordsRequest
-	(*ListMedicalRecordsResponse)(nil),              // 16: healthanalytics.ListMedicalRecordsResponse
-	(*LifestyleData)(nil),                           // 17: healthanalytics.LifestyleData
-	(*AddLifestyleDataRequest)(nil),                 // 18: healthanalytics.AddLifestyleDataRequest
-	(*AddLifestyleDataResponse)(nil),                // 19: healthanalytics.AddLifestyleDataResponse
-	(*GetLifestyleDataRequest)(nil),                 // 20: healthanalytics.GetLifestyleDataRequest
-	(*GetLifestyleDataResponse)(nil),                // 21: healthanalytics.GetLifestyleDataResponse
-	(*UpdateLifestyleDataRequest)(nil),              // 22: healthanalytics.UpdateLifestyleDataRequest
-	(*UpdateLifestyleDataResponse)(nil),             // 23: healthanalytics.UpdateLifestyleDataResponse
-	(*DeleteLifestyleDataRequest)(nil),              // 24: healthanalytics.DeleteLifestyleDataRequest
-	(*DeleteLifestyleDataResponse)(nil),             // 25: healthanalytics.DeleteLifestyleDataResponse
-	(*WearableData)(nil),                            // 26: healthanalytics.WearableData
-	(*AddWearableDataRequest)(nil),                  // 27: healthanalytics.AddWearableDataRequest
-	(*AddWearableDataResponse)(nil),                 // 28: healthanalytics.AddWearableDataResponse
-	(*GetWearableDataRequest)(nil),                  // 29: healthanalytics.GetWearableDataRequest
-	(*GetWearableDataResponse)(nil),                 // 30: healthanalytics.GetWearableDataResponse
-	(*UpdateWearableDataRequest)(nil),               // 31: healthanalytics.UpdateWearableDataRequest
-	(*UpdateWearableDataResponse)(nil),              // 32: healthanalytics.UpdateWearableDataResponse
-	(*DeleteWearableDataRequest)(nil),               // 33: healthanalytics.DeleteWearableDataRequest
-	(*DeleteWearableDataResponse)(nil),              // 34: healthanalytics.DeleteWearableDataResponse
-	(*HealthRecommendation)(nil),                    // 35: healthanalytics.HealthRecommendation
-	(*GenerateHealthRecommendationsRequest)(nil),    // 36: healthanalytics.GenerateHealthRecommendationsRequest
-	(*GenerateHealthRecommendationsResponse)(nil),   // 37: healthanalytics.GenerateHealthRecommendationsResponse
-	(*GetRealtimeHealthMonitoringRequest)(nil),      // 38: healthanalytics.GetRealtimeHealthMonitoringRequest
-	(*GetRealtimeHealthMonitoringResponse)(nil),     // 39: healthanalytics.GetRealtimeHealthMonitoringResponse
-	(*GetDailyHealthSummaryRequest)(nil),            // 40: healthanalytics.GetDailyHealthSummaryRequest
-	(*GetDailyHealthSummaryResponse)(nil),           // 41: healthanalytics.GetDailyHealthSummaryResponse
-	(*GetWeeklyHealthSummaryRequest)(nil),           // 42: healthanalytics.GetWeeklyHealthSummaryRequest
-	(*GetWeeklyHealthSummaryResponse)(nil),          // 43: healthanalytics.GetWeeklyHealthSummaryResponse
+func (*AddWearableDataBatchRequest) ProtoMessage() {}
+
+func (x *AddWearableDataBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_depIdxs = []int32{
-	35, // 0: healthanalytics.GenerateHealthRecommendationsIdResponse.recommendations:type_name -> healthanalytics.HealthRecommendation
-	26, // 1: healthanalytics.GetAllWearableDataResponse.wearabledata:type_name -> healthanalytics.WearableData
-	17, // 2: healthanalytics.GetAllLifestyleDataResponse.lifestyledata:type_name -> healthanalytics.LifestyleData
-	6,  // 3: healthanalytics.AddMedicalRecordResponse.medical_record:type_name -> healthanalytics.MedicalRecord
-	6,  // 4: healthanalytics.GetMedicalRecordResponse.medical_record:type_name -> healthanalytics.MedicalRecord
-	6,  // 5: healthanalytics.ListMedicalRecordsResponse.medical_records:type_name -> healthanalytics.MedicalRecord
-	17, // 6: healthanalytics.AddLifestyleDataResponse.lifestyleData:type_name -> healthanalytics.LifestyleData
-	17, // 7: healthanalytics.GetLifestyleDataResponse.lifestyle_data:type_name -> healthanalytics.LifestyleData
-	26, // 8: healthanalytics.AddWearableDataResponse.wearableData:type_name -> healthanalytics.WearableData
-	26, // 9: healthanalytics.GetWearableDataResponse.wearable_data:type_name -> healthanalytics.WearableData
-	35, // 10: healthanalytics.GenerateHealthRecommendationsResponse.recommendations:type_name -> healthanalytics.HealthRecommendation
-	35, // 11: healthanalytics.GetWeeklyHealthSummaryResponse.health:type_name -> healthanalytics.HealthRecommendation
-	7,  // 12: healthanalytics.HealthAnalyticsService.AddMedicalRecord:input_type -> healthanalytics.AddMedicalRecordRequest
-	9,  // 13: healthanalytics.HealthAnalyticsService.GetMedicalRecord:input_type -> healthanalytics.GetMedicalRecordRequest
-	11, // 14: healthanalytics.HealthAnalyticsService.UpdateMedicalRecord:input_type -> healthanalytics.UpdateMedicalRecordRequest
-	13, // 15: healthanalytics.HealthAnalyticsService.DeleteMedicalRecord:input_type -> healthanalytics.DeleteMedicalRecordRequest
-	15, // 16: healthanalytics.HealthAnalyticsService.ListMedicalRecords:input_type -> healthanalytics.ListMedicalRecordsRequest
-	18, // 17: healthanalytics.HealthAnalyticsService.AddLifestyleData:input_type -> healthanalytics.AddLifestyleDataRequest
-	20, // 18: healthanalytics.HealthAnalyticsService.GetLifestyleData:input_type -> healthanalytics.GetLifestyleDataRequest
-	5,  // 19: healthanalytics.HealthAnalyticsService.GetAllLifestyleData:input_type -> healthanalytics.GetAllLifestyleDataRequest
-	22, // 20: healthanalytics.HealthAnalyticsService.UpdateLifestyleData:input_type -> healthanalytics.UpdateLifestyleDataRequest
-	24, // 21: healthanalytics.HealthAnalyticsService.DeleteLifestyleData:input_type -> healthanalytics.DeleteLifestyleDataRequest
-	27, // 22: healthanalytics.HealthAnalyticsService.AddWearableData:input_type -> healthanalytics.AddWearableDataRequest
-	29, // 23: healthanalytics.HealthAnalyticsService.GetWearableData:input_type -> healthanalytics.GetWearableDataRequest
-	3,  // 24: healthanalytics.HealthAnalyticsService.GetAllWearableData:input_type -> healthanalytics.GetAllWearableDataRequest
-	31, // 25: healthanalytics.HealthAnalyticsService.UpdateWearableData:input_type -> healthanalytics.UpdateWearableDataRequest
-	33, // 26: healthanalytics.HealthAnalyticsService.DeleteWearableData:input_type -> healthanalytics.DeleteWearableDataRequest
-	36, // 27: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendations:input_type -> healthanalytics.GenerateHealthRecommendationsRequest
-	1,  // 28: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendationsId:input_type -> healthanalytics.GenerateHealthRecommendationsIdRequest
-	38, // 29: healthanalytics.HealthAnalyticsService.GetRealtimeHealthMonitoring:input_type -> healthanalytics.GetRealtimeHealthMonitoringRequest
-	40, // 30: healthanalytics.HealthAnalyticsService.GetDailyHealthSummary:input_type -> healthanalytics.GetDailyHealthSummaryRequest
-	42, // 31: healthanalytics.HealthAnalyticsService.GetWeeklyHealthSummary:input_type -> healthanalytics.GetWeeklyHealthSummaryRequest
-	38, // 32: healthanalytics.HealthAnalyticsService.UserIDHealth:input_type -> healthanalytics.GetRealtimeHealthMonitoringRequest
-	8,  // 33: healthanalytics.HealthAnalyticsService.AddMedicalRecord:output_type -> healthanalytics.AddMedicalRecordResponse
-	10, // 34: healthanalytics.HealthAnalyticsService.GetMedicalRecord:output_type -> healthanalytics.GetMedicalRecordResponse
-	12, // 35: healthanalytics.HealthAnalyticsService.UpdateMedicalRecord:output_type -> healthanalytics.UpdateMedicalRecordResponse
-	14, // 36: healthanalytics.HealthAnalyticsService.DeleteMedicalRecord:output_type -> healthanalytics.DeleteMedicalRecordResponse
-	16, // 37: healthanalytics.HealthAnalyticsService.ListMedicalRecords:output_type -> healthanalytics.ListMedicalRecordsResponse
-	19, // 38: healthanalytics.HealthAnalyticsService.AddLifestyleData:output_type -> healthanalytics.AddLifestyleDataResponse
-	21, // 39: healthanalytics.HealthAnalyticsService.GetLifestyleData:output_type -> healthanalytics.GetLifestyleDataResponse
-	4,  // 40: healthanalytics.HealthAnalyticsService.GetAllLifestyleData:output_type -> healthanalytics.GetAllLifestyleDataResponse
-	23, // 41: healthanalytics.HealthAnalyticsService.UpdateLifestyleData:output_type -> healthanalytics.UpdateLifestyleDataResponse
-	25, // 42: healthanalytics.HealthAnalyticsService.DeleteLifestyleData:output_type -> healthanalytics.DeleteLifestyleDataResponse
-	28, // 43: healthanalytics.HealthAnalyticsService.AddWearableData:output_type -> healthanalytics.AddWearableDataResponse
-	30, // 44: healthanalytics.HealthAnalyticsService.GetWearableData:output_type -> healthanalytics.GetWearableDataResponse
-	2,  // 45: healthanalytics.HealthAnalyticsService.GetAllWearableData:output_type -> healthanalytics.GetAllWearableDataResponse
-	32, // 46: healthanalytics.HealthAnalyticsService.UpdateWearableData:output_type -> healthanalytics.UpdateWearableDataResponse
-	34, // 47: healthanalytics.HealthAnalyticsService.DeleteWearableData:output_type -> healthanalytics.DeleteWearableDataResponse
-	37, // 48: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendations:output_type -> healthanalytics.GenerateHealthRecommendationsResponse
-	0,  // 49: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendationsId:output_type -> healthanalytics.GenerateHealthRecommendationsIdResponse
-	39, // 50: healthanalytics.HealthAnalyticsService.GetRealtimeHealthMonitoring:output_type -> healthanalytics.GetRealtimeHealthMonitoringResponse
-	41, // 51: healthanalytics.HealthAnalyticsService.GetDailyHealthSummary:output_type -> healthanalytics.GetDailyHealthSummaryResponse
-	43, // 52: healthanalytics.HealthAnalyticsService.GetWeeklyHealthSummary:output_type -> healthanalytics.GetWeeklyHealthSummaryResponse
-	39, // 53: healthanalytics.HealthAnalyticsService.UserIDHealth:output_type -> healthanalytics.GetRealtimeHealthMonitoringResponse
-	33, // [33:54] is the sub-list for method output_type
-	12, // [12:33] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+// Deprecated: Use AddWearableDataBatchRequest.ProtoReflect.Descriptor instead.
+func (*AddWearableDataBatchRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{46}
 }
 
-func init() { file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init() }
-func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init() {
-	if File_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto != nil {
-		return
+func (x *AddWearableDataBatchRequest) GetReadings() []*AddWearableDataRequest {
+	if x != nil {
+		return x.Readings
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateHealthRecommendationsIdResponse); i {
+	return nil
+}
+
+// wearable_data o'lchovlar so'rovdagi tartibda
+type AddWearableDataBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WearableData []*WearableData `protobuf:"bytes,1,rep,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
+}
+
+func (x *AddWearableDataBatchResponse) Reset() {
+	*x = AddWearableDataBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddWearableDataBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWearableDataBatchResponse) ProtoMessage() {}
+
+func (x *AddWearableDataBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWearableDataBatchResponse.ProtoReflect.Descriptor instead.
+func (*AddWearableDataBatchResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddWearableDataBatchResponse) GetWearableData() []*WearableData {
+	if x != nil {
+		return x.WearableData
+	}
+	return nil
+}
+
+type GetWearableDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetWearableDataRequest) Reset() {
+	*x = GetWearableDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWearableDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWearableDataRequest) ProtoMessage() {}
+
+func (x *GetWearableDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWearableDataRequest.ProtoReflect.Descriptor instead.
+func (*GetWearableDataRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetWearableDataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWearableDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WearableData *WearableData `protobuf:"bytes,1,opt,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
+}
+
+func (x *GetWearableDataResponse) Reset() {
+	*x = GetWearableDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWearableDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWearableDataResponse) ProtoMessage() {}
+
+func (x *GetWearableDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWearableDataResponse.ProtoReflect.Descriptor instead.
+func (*GetWearableDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetWearableDataResponse) GetWearableData() *WearableData {
+	if x != nil {
+		return x.WearableData
+	}
+	return nil
+}
+
+type UpdateWearableDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceType        string                 `protobuf:"bytes,3,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"`
+	DataType          string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	DataValue         string                 `protobuf:"bytes,5,opt,name=data_value,json=dataValue,proto3" json:"data_value,omitempty"`
+	RecordedTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=recorded_timestamp,json=recordedTimestamp,proto3" json:"recorded_timestamp,omitempty"`
+	// berilmasa data_value dan ajratib olinadi
+	Measurement *Measurement `protobuf:"bytes,7,opt,name=measurement,proto3" json:"measurement,omitempty"`
+}
+
+func (x *UpdateWearableDataRequest) Reset() {
+	*x = UpdateWearableDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWearableDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWearableDataRequest) ProtoMessage() {}
+
+func (x *UpdateWearableDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWearableDataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWearableDataRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateWearableDataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWearableDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateWearableDataRequest) GetDeviceType() string {
+	if x != nil {
+		return x.DeviceType
+	}
+	return ""
+}
+
+func (x *UpdateWearableDataRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *UpdateWearableDataRequest) GetDataValue() string {
+	if x != nil {
+		return x.DataValue
+	}
+	return ""
+}
+
+func (x *UpdateWearableDataRequest) GetRecordedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedTimestamp
+	}
+	return nil
+}
+
+func (x *UpdateWearableDataRequest) GetMeasurement() *Measurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+type UpdateWearableDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UpdateWearableDataResponse) Reset() {
+	*x = UpdateWearableDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWearableDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWearableDataResponse) ProtoMessage() {}
+
+func (x *UpdateWearableDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWearableDataResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWearableDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateWearableDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteWearableDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWearableDataRequest) Reset() {
+	*x = DeleteWearableDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWearableDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWearableDataRequest) ProtoMessage() {}
+
+func (x *DeleteWearableDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWearableDataRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWearableDataRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteWearableDataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWearableDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteWearableDataResponse) Reset() {
+	*x = DeleteWearableDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWearableDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWearableDataResponse) ProtoMessage() {}
+
+func (x *DeleteWearableDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWearableDataResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWearableDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteWearableDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// RestoreWearableData o'chirilgan yozuvni qaytaradi. Yozuv user_id ga tegishli bo'lishi kerak.
+type RestoreWearableDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *RestoreWearableDataRequest) Reset() {
+	*x = RestoreWearableDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWearableDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWearableDataRequest) ProtoMessage() {}
+
+func (x *RestoreWearableDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWearableDataRequest.ProtoReflect.Descriptor instead.
+func (*RestoreWearableDataRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RestoreWearableDataRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreWearableDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreWearableDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RestoreWearableDataResponse) Reset() {
+	*x = RestoreWearableDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreWearableDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreWearableDataResponse) ProtoMessage() {}
+
+func (x *RestoreWearableDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreWearableDataResponse.ProtoReflect.Descriptor instead.
+func (*RestoreWearableDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RestoreWearableDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListDeletedWearableData foydalanuvchining o'chirilgan yozuvlari, yangi
+// o'chirilganlaridan boshlab
+type ListDeletedWearableDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListDeletedWearableDataRequest) Reset() {
+	*x = ListDeletedWearableDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedWearableDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedWearableDataRequest) ProtoMessage() {}
+
+func (x *ListDeletedWearableDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedWearableDataRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedWearableDataRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListDeletedWearableDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDeletedWearableDataRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeletedWearableDataRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListDeletedWearableDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WearableData []*WearableData `protobuf:"bytes,1,rep,name=wearable_data,json=wearableData,proto3" json:"wearable_data,omitempty"`
+	NextCursor   string          `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListDeletedWearableDataResponse) Reset() {
+	*x = ListDeletedWearableDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeletedWearableDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedWearableDataResponse) ProtoMessage() {}
+
+func (x *ListDeletedWearableDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedWearableDataResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedWearableDataResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListDeletedWearableDataResponse) GetWearableData() []*WearableData {
+	if x != nil {
+		return x.WearableData
+	}
+	return nil
+}
+
+func (x *ListDeletedWearableDataResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Sog'liq tavsiyalari va monitoringi uchun message'lar
+type HealthRecommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecommendationType string                 `protobuf:"bytes,3,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Priority           int32                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *HealthRecommendation) Reset() {
+	*x = HealthRecommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthRecommendation) ProtoMessage() {}
+
+func (x *HealthRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthRecommendation.ProtoReflect.Descriptor instead.
+func (*HealthRecommendation) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *HealthRecommendation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HealthRecommendation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HealthRecommendation) GetRecommendationType() string {
+	if x != nil {
+		return x.RecommendationType
+	}
+	return ""
+}
+
+func (x *HealthRecommendation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HealthRecommendation) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *HealthRecommendation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *HealthRecommendation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GenerateHealthRecommendationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// bo'sh bo'lmasa faqat shu turdagi qoidalar tekshiriladi
+	RecommendationType string `protobuf:"bytes,2,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
+}
+
+func (x *GenerateHealthRecommendationsRequest) Reset() {
+	*x = GenerateHealthRecommendationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateHealthRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateHealthRecommendationsRequest) ProtoMessage() {}
+
+func (x *GenerateHealthRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateHealthRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateHealthRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GenerateHealthRecommendationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GenerateHealthRecommendationsRequest) GetRecommendationType() string {
+	if x != nil {
+		return x.RecommendationType
+	}
+	return ""
+}
+
+type GenerateHealthRecommendationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recommendations []*HealthRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+}
+
+func (x *GenerateHealthRecommendationsResponse) Reset() {
+	*x = GenerateHealthRecommendationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateHealthRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateHealthRecommendationsResponse) ProtoMessage() {}
+
+func (x *GenerateHealthRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateHealthRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateHealthRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GenerateHealthRecommendationsResponse) GetRecommendations() []*HealthRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+type GetRealtimeHealthMonitoringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetRealtimeHealthMonitoringRequest) Reset() {
+	*x = GetRealtimeHealthMonitoringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealtimeHealthMonitoringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealtimeHealthMonitoringRequest) ProtoMessage() {}
+
+func (x *GetRealtimeHealthMonitoringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealtimeHealthMonitoringRequest.ProtoReflect.Descriptor instead.
+func (*GetRealtimeHealthMonitoringRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetRealtimeHealthMonitoringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetRealtimeHealthMonitoringResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName          string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName           string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	RecommendationType string `protobuf:"bytes,3,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
+	Description        string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Priority           int32  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (x *GetRealtimeHealthMonitoringResponse) Reset() {
+	*x = GetRealtimeHealthMonitoringResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRealtimeHealthMonitoringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRealtimeHealthMonitoringResponse) ProtoMessage() {}
+
+func (x *GetRealtimeHealthMonitoringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRealtimeHealthMonitoringResponse.ProtoReflect.Descriptor instead.
+func (*GetRealtimeHealthMonitoringResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetRealtimeHealthMonitoringResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *GetRealtimeHealthMonitoringResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *GetRealtimeHealthMonitoringResponse) GetRecommendationType() string {
+	if x != nil {
+		return x.RecommendationType
+	}
+	return ""
+}
+
+func (x *GetRealtimeHealthMonitoringResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetRealtimeHealthMonitoringResponse) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type StreamRealtimeHealthMonitoringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *StreamRealtimeHealthMonitoringRequest) Reset() {
+	*x = StreamRealtimeHealthMonitoringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRealtimeHealthMonitoringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRealtimeHealthMonitoringRequest) ProtoMessage() {}
+
+func (x *StreamRealtimeHealthMonitoringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRealtimeHealthMonitoringRequest.ProtoReflect.Descriptor instead.
+func (*StreamRealtimeHealthMonitoringRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StreamRealtimeHealthMonitoringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// RealtimeHealthEvent navbatdan o'qilgan yangi o'lchov yoki tavsiya
+type RealtimeHealthEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*RealtimeHealthEvent_WearableData
+	//	*RealtimeHealthEvent_Recommendation
+	//	*RealtimeHealthEvent_Alert
+	Event isRealtimeHealthEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *RealtimeHealthEvent) Reset() {
+	*x = RealtimeHealthEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RealtimeHealthEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealtimeHealthEvent) ProtoMessage() {}
+
+func (x *RealtimeHealthEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealtimeHealthEvent.ProtoReflect.Descriptor instead.
+func (*RealtimeHealthEvent) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{64}
+}
+
+func (m *RealtimeHealthEvent) GetEvent() isRealtimeHealthEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *RealtimeHealthEvent) GetWearableData() *WearableData {
+	if x, ok := x.GetEvent().(*RealtimeHealthEvent_WearableData); ok {
+		return x.WearableData
+	}
+	return nil
+}
+
+func (x *RealtimeHealthEvent) GetRecommendation() *HealthRecommendation {
+	if x, ok := x.GetEvent().(*RealtimeHealthEvent_Recommendation); ok {
+		return x.Recommendation
+	}
+	return nil
+}
+
+func (x *RealtimeHealthEvent) GetAlert() *Alert {
+	if x, ok := x.GetEvent().(*RealtimeHealthEvent_Alert); ok {
+		return x.Alert
+	}
+	return nil
+}
+
+type isRealtimeHealthEvent_Event interface {
+	isRealtimeHealthEvent_Event()
+}
+
+type RealtimeHealthEvent_WearableData struct {
+	WearableData *WearableData `protobuf:"bytes,1,opt,name=wearable_data,json=wearableData,proto3,oneof"`
+}
+
+type RealtimeHealthEvent_Recommendation struct {
+	Recommendation *HealthRecommendation `protobuf:"bytes,2,opt,name=recommendation,proto3,oneof"`
+}
+
+type RealtimeHealthEvent_Alert struct {
+	Alert *Alert `protobuf:"bytes,3,opt,name=alert,proto3,oneof"`
+}
+
+func (*RealtimeHealthEvent_WearableData) isRealtimeHealthEvent_Event() {}
+
+func (*RealtimeHealthEvent_Recommendation) isRealtimeHealthEvent_Event() {}
+
+func (*RealtimeHealthEvent_Alert) isRealtimeHealthEvent_Event() {}
+
+// GetDailyHealthSummaryRequest date 2006-01-02 formatidagi kun, u x-timezone
+// metadata sida berilgan zonada hisoblanadi. Metadata bo'lmasa foydalanuvchining
+// SetUserTimezone bilan saqlangan zonasi, u ham bo'lmasa UTC olinadi.
+type GetDailyHealthSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Date   string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *GetDailyHealthSummaryRequest) Reset() {
+	*x = GetDailyHealthSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyHealthSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyHealthSummaryRequest) ProtoMessage() {}
+
+func (x *GetDailyHealthSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyHealthSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyHealthSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetDailyHealthSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDailyHealthSummaryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// MetricSummary bitta data_type bo'yicha yig'ilgan ko'rsatkichlar
+type MetricSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// wearable_data yoki lifestyle_data
+	Source   string  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	DataType string  `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Min      float64 `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max      float64 `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Avg      float64 `protobuf:"fixed64,5,opt,name=avg,proto3" json:"avg,omitempty"`
+	Count    int64   `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MetricSummary) Reset() {
+	*x = MetricSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricSummary) ProtoMessage() {}
+
+func (x *MetricSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricSummary.ProtoReflect.Descriptor instead.
+func (*MetricSummary) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *MetricSummary) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MetricSummary) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *MetricSummary) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *MetricSummary) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *MetricSummary) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *MetricSummary) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetDailyHealthSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName       string                  `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string                  `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Date            string                  `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Metrics         []*MetricSummary        `protobuf:"bytes,7,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Recommendations []*HealthRecommendation `protobuf:"bytes,8,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+}
+
+func (x *GetDailyHealthSummaryResponse) Reset() {
+	*x = GetDailyHealthSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDailyHealthSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyHealthSummaryResponse) ProtoMessage() {}
+
+func (x *GetDailyHealthSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyHealthSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyHealthSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetDailyHealthSummaryResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *GetDailyHealthSummaryResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *GetDailyHealthSummaryResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDailyHealthSummaryResponse) GetMetrics() []*MetricSummary {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *GetDailyHealthSummaryResponse) GetRecommendations() []*HealthRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+// GetWeeklyHealthSummaryRequest start_date kuni ham x-timezone zonasida hisoblanadi
+type GetWeeklyHealthSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+}
+
+func (x *GetWeeklyHealthSummaryRequest) Reset() {
+	*x = GetWeeklyHealthSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWeeklyHealthSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeeklyHealthSummaryRequest) ProtoMessage() {}
+
+func (x *GetWeeklyHealthSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeeklyHealthSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetWeeklyHealthSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetWeeklyHealthSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetWeeklyHealthSummaryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+// DailyMetrics haftalik xulosadagi bir kunlik ko'rsatkichlar
+type DailyMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date    string           `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Metrics []*MetricSummary `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *DailyMetrics) Reset() {
+	*x = DailyMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyMetrics) ProtoMessage() {}
+
+func (x *DailyMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyMetrics.ProtoReflect.Descriptor instead.
+func (*DailyMetrics) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DailyMetrics) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyMetrics) GetMetrics() []*MetricSummary {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// MetricTrend bitta ko'rsatkichning hafta davomidagi o'zgarishi
+type MetricTrend struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source      string  `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	DataType    string  `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Avg         float64 `protobuf:"fixed64,3,opt,name=avg,proto3" json:"avg,omitempty"`
+	PreviousAvg float64 `protobuf:"fixed64,4,opt,name=previous_avg,json=previousAvg,proto3" json:"previous_avg,omitempty"`
+	// oldingi haftaga nisbatan foiz, oldingi haftada ma'lumot bo'lmasa 0
+	ChangePercent float64 `protobuf:"fixed64,5,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"`
+	// up, down yoki stable
+	Direction string `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty"`
+}
+
+func (x *MetricTrend) Reset() {
+	*x = MetricTrend{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricTrend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricTrend) ProtoMessage() {}
+
+func (x *MetricTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricTrend.ProtoReflect.Descriptor instead.
+func (*MetricTrend) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MetricTrend) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MetricTrend) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *MetricTrend) GetAvg() float64 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *MetricTrend) GetPreviousAvg() float64 {
+	if x != nil {
+		return x.PreviousAvg
+	}
+	return 0
+}
+
+func (x *MetricTrend) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+func (x *MetricTrend) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type GetWeeklyHealthSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Health    []*HealthRecommendation `protobuf:"bytes,1,rep,name=health,proto3" json:"health,omitempty"`
+	StartDate string                  `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string                  `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// start_date dan boshlab 7 kun, ma'lumot bo'lmagan kunlar ham qaytariladi
+	Days   []*DailyMetrics `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	Trends []*MetricTrend  `protobuf:"bytes,5,rep,name=trends,proto3" json:"trends,omitempty"`
+}
+
+func (x *GetWeeklyHealthSummaryResponse) Reset() {
+	*x = GetWeeklyHealthSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWeeklyHealthSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeeklyHealthSummaryResponse) ProtoMessage() {}
+
+func (x *GetWeeklyHealthSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeeklyHealthSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetWeeklyHealthSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetWeeklyHealthSummaryResponse) GetHealth() []*HealthRecommendation {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *GetWeeklyHealthSummaryResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetWeeklyHealthSummaryResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetWeeklyHealthSummaryResponse) GetDays() []*DailyMetrics {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetWeeklyHealthSummaryResponse) GetTrends() []*MetricTrend {
+	if x != nil {
+		return x.Trends
+	}
+	return nil
+}
+
+type UserIDHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// bo'sh bo'lmasa faqat shu turdagi tavsiyalar qaytariladi
+	RecommendationType string `protobuf:"bytes,2,opt,name=recommendation_type,json=recommendationType,proto3" json:"recommendation_type,omitempty"`
+	Limit              int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// oldingi javobdagi next_cursor, birinchi sahifa uchun bo'sh
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *UserIDHealthRequest) Reset() {
+	*x = UserIDHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserIDHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDHealthRequest) ProtoMessage() {}
+
+func (x *UserIDHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIDHealthRequest.ProtoReflect.Descriptor instead.
+func (*UserIDHealthRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UserIDHealthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserIDHealthRequest) GetRecommendationType() string {
+	if x != nil {
+		return x.RecommendationType
+	}
+	return ""
+}
+
+func (x *UserIDHealthRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *UserIDHealthRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type UserIDHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recommendations []*HealthRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	// keyingi sahifa yo'q bo'lsa bo'sh
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *UserIDHealthResponse) Reset() {
+	*x = UserIDHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserIDHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDHealthResponse) ProtoMessage() {}
+
+func (x *UserIDHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIDHealthResponse.ProtoReflect.Descriptor instead.
+func (*UserIDHealthResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UserIDHealthResponse) GetRecommendations() []*HealthRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+func (x *UserIDHealthResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Ogohlantirishlar uchun message'lar
+//
+// AlertThreshold o'lchov qiymati uchun chegaralar. user_id bo'sh bo'lsa default
+// chegara, foydalanuvchi chegarasi esa shu data_type uchun defaultlarni almashtiradi.
+type AlertThreshold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// heart_rate, spo2, blood_pressure_systolic, blood_pressure_diastolic
+	DataType string   `protobuf:"bytes,3,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	MinValue *float64 `protobuf:"fixed64,4,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue *float64 `protobuf:"fixed64,5,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	// info, warning yoki critical
+	Severity  string                 `protobuf:"bytes,6,opt,name=severity,proto3" json:"severity,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AlertThreshold) Reset() {
+	*x = AlertThreshold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlertThreshold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertThreshold) ProtoMessage() {}
+
+func (x *AlertThreshold) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertThreshold.ProtoReflect.Descriptor instead.
+func (*AlertThreshold) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AlertThreshold) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AlertThreshold) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AlertThreshold) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *AlertThreshold) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *AlertThreshold) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *AlertThreshold) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AlertThreshold) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AlertThreshold) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateAlertThresholdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DataType string   `protobuf:"bytes,2,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	MinValue *float64 `protobuf:"fixed64,3,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue *float64 `protobuf:"fixed64,4,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	Severity string   `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity,omitempty"`
+}
+
+func (x *CreateAlertThresholdRequest) Reset() {
+	*x = CreateAlertThresholdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAlertThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertThresholdRequest) ProtoMessage() {}
+
+func (x *CreateAlertThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertThresholdRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateAlertThresholdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateAlertThresholdRequest) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *CreateAlertThresholdRequest) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *CreateAlertThresholdRequest) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *CreateAlertThresholdRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type CreateAlertThresholdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Threshold *AlertThreshold `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *CreateAlertThresholdResponse) Reset() {
+	*x = CreateAlertThresholdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAlertThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertThresholdResponse) ProtoMessage() {}
+
+func (x *CreateAlertThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertThresholdResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateAlertThresholdResponse) GetThreshold() *AlertThreshold {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+type ListAlertThresholdsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bo'sh bo'lsa faqat default chegaralar qaytariladi
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *ListAlertThresholdsRequest) Reset() {
+	*x = ListAlertThresholdsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAlertThresholdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertThresholdsRequest) ProtoMessage() {}
+
+func (x *ListAlertThresholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertThresholdsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertThresholdsRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListAlertThresholdsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListAlertThresholdsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Thresholds []*AlertThreshold `protobuf:"bytes,1,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
+}
+
+func (x *ListAlertThresholdsResponse) Reset() {
+	*x = ListAlertThresholdsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAlertThresholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertThresholdsResponse) ProtoMessage() {}
+
+func (x *ListAlertThresholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertThresholdsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertThresholdsResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListAlertThresholdsResponse) GetThresholds() []*AlertThreshold {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+type UpdateAlertThresholdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MinValue *float64 `protobuf:"fixed64,2,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	MaxValue *float64 `protobuf:"fixed64,3,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	Severity string   `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"`
+}
+
+func (x *UpdateAlertThresholdRequest) Reset() {
+	*x = UpdateAlertThresholdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAlertThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertThresholdRequest) ProtoMessage() {}
+
+func (x *UpdateAlertThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertThresholdRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateAlertThresholdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAlertThresholdRequest) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *UpdateAlertThresholdRequest) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *UpdateAlertThresholdRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type UpdateAlertThresholdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UpdateAlertThresholdResponse) Reset() {
+	*x = UpdateAlertThresholdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAlertThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertThresholdResponse) ProtoMessage() {}
+
+func (x *UpdateAlertThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertThresholdResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateAlertThresholdResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteAlertThresholdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteAlertThresholdRequest) Reset() {
+	*x = DeleteAlertThresholdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAlertThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertThresholdRequest) ProtoMessage() {}
+
+func (x *DeleteAlertThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertThresholdRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteAlertThresholdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteAlertThresholdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteAlertThresholdResponse) Reset() {
+	*x = DeleteAlertThresholdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAlertThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertThresholdResponse) ProtoMessage() {}
+
+func (x *DeleteAlertThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertThresholdResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteAlertThresholdResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WearableDataId string                 `protobuf:"bytes,3,opt,name=wearable_data_id,json=wearableDataId,proto3" json:"wearable_data_id,omitempty"`
+	DataType       string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Value          float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	ThresholdId    string                 `protobuf:"bytes,6,opt,name=threshold_id,json=thresholdId,proto3" json:"threshold_id,omitempty"`
+	Severity       string                 `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
+	Message        string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Acknowledged   bool                   `protobuf:"varint,9,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	AcknowledgedBy string                 `protobuf:"bytes,10,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Alert) GetWearableDataId() string {
+	if x != nil {
+		return x.WearableDataId
+	}
+	return ""
+}
+
+func (x *Alert) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *Alert) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Alert) GetThresholdId() string {
+	if x != nil {
+		return x.ThresholdId
+	}
+	return ""
+}
+
+func (x *Alert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+func (x *Alert) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+func (x *Alert) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId             string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnacknowledgedOnly bool   `protobuf:"varint,2,opt,name=unacknowledged_only,json=unacknowledgedOnly,proto3" json:"unacknowledged_only,omitempty"`
+	Limit              int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor             string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsRequest) ProtoMessage() {}
+
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListAlertsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAlertsRequest) GetUnacknowledgedOnly() bool {
+	if x != nil {
+		return x.UnacknowledgedOnly
+	}
+	return false
+}
+
+func (x *ListAlertsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAlertsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts     []*Alert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	NextCursor string   `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsResponse) ProtoMessage() {}
+
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListAlertsResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *ListAlertsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type AcknowledgeAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// acknowledged_by tokendagi foydalanuvchidan olinadi. Berilsa u bilan bir xil
+	// bo'lishi kerak, aks holda InvalidArgument qaytadi.
+	AcknowledgedBy string `protobuf:"bytes,2,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+}
+
+func (x *AcknowledgeAlertRequest) Reset() {
+	*x = AcknowledgeAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlertRequest) ProtoMessage() {}
+
+func (x *AcknowledgeAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAlertRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlertRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AcknowledgeAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AcknowledgeAlertRequest) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+type AcknowledgeAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *AcknowledgeAlertResponse) Reset() {
+	*x = AcknowledgeAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlertResponse) ProtoMessage() {}
+
+func (x *AcknowledgeAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAlertResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlertResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *AcknowledgeAlertResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Shifokor va bemor roziligi uchun message'lar
+//
+// DoctorConsent bemorning shifokorga o'z yozuvlarini ko'rishga bergan roziligi
+type DoctorConsent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId string                 `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId  string                 `protobuf:"bytes,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *DoctorConsent) Reset() {
+	*x = DoctorConsent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoctorConsent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoctorConsent) ProtoMessage() {}
+
+func (x *DoctorConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoctorConsent.ProtoReflect.Descriptor instead.
+func (*DoctorConsent) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *DoctorConsent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DoctorConsent) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *DoctorConsent) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *DoctorConsent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GrantDoctorConsentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PatientId string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId  string `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+}
+
+func (x *GrantDoctorConsentRequest) Reset() {
+	*x = GrantDoctorConsentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantDoctorConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantDoctorConsentRequest) ProtoMessage() {}
+
+func (x *GrantDoctorConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantDoctorConsentRequest.ProtoReflect.Descriptor instead.
+func (*GrantDoctorConsentRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GrantDoctorConsentRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *GrantDoctorConsentRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+type GrantDoctorConsentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consent *DoctorConsent `protobuf:"bytes,1,opt,name=consent,proto3" json:"consent,omitempty"`
+}
+
+func (x *GrantDoctorConsentResponse) Reset() {
+	*x = GrantDoctorConsentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantDoctorConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantDoctorConsentResponse) ProtoMessage() {}
+
+func (x *GrantDoctorConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantDoctorConsentResponse.ProtoReflect.Descriptor instead.
+func (*GrantDoctorConsentResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GrantDoctorConsentResponse) GetConsent() *DoctorConsent {
+	if x != nil {
+		return x.Consent
+	}
+	return nil
+}
+
+type RevokeDoctorConsentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PatientId string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId  string `protobuf:"bytes,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+}
+
+func (x *RevokeDoctorConsentRequest) Reset() {
+	*x = RevokeDoctorConsentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeDoctorConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDoctorConsentRequest) ProtoMessage() {}
+
+func (x *RevokeDoctorConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDoctorConsentRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDoctorConsentRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RevokeDoctorConsentRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *RevokeDoctorConsentRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+type RevokeDoctorConsentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RevokeDoctorConsentResponse) Reset() {
+	*x = RevokeDoctorConsentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeDoctorConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDoctorConsentResponse) ProtoMessage() {}
+
+func (x *RevokeDoctorConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDoctorConsentResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDoctorConsentResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RevokeDoctorConsentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListDoctorConsentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PatientId string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+}
+
+func (x *ListDoctorConsentsRequest) Reset() {
+	*x = ListDoctorConsentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDoctorConsentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDoctorConsentsRequest) ProtoMessage() {}
+
+func (x *ListDoctorConsentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDoctorConsentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDoctorConsentsRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListDoctorConsentsRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+type ListDoctorConsentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consents []*DoctorConsent `protobuf:"bytes,1,rep,name=consents,proto3" json:"consents,omitempty"`
+}
+
+func (x *ListDoctorConsentsResponse) Reset() {
+	*x = ListDoctorConsentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDoctorConsentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDoctorConsentsResponse) ProtoMessage() {}
+
+func (x *ListDoctorConsentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDoctorConsentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDoctorConsentsResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListDoctorConsentsResponse) GetConsents() []*DoctorConsent {
+	if x != nil {
+		return x.Consents
+	}
+	return nil
+}
+
+// DoctorPatient shifokorga rozilik bergan bemor
+type DoctorPatient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PatientId string `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	FirstName string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// rozilik berilgan vaqt
+	ConsentedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=consented_at,json=consentedAt,proto3" json:"consented_at,omitempty"`
+}
+
+func (x *DoctorPatient) Reset() {
+	*x = DoctorPatient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoctorPatient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoctorPatient) ProtoMessage() {}
+
+func (x *DoctorPatient) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoctorPatient.ProtoReflect.Descriptor instead.
+func (*DoctorPatient) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DoctorPatient) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *DoctorPatient) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *DoctorPatient) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *DoctorPatient) GetConsentedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConsentedAt
+	}
+	return nil
+}
+
+type ListPatientsForDoctorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DoctorId string `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Limit    int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor   string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListPatientsForDoctorRequest) Reset() {
+	*x = ListPatientsForDoctorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPatientsForDoctorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatientsForDoctorRequest) ProtoMessage() {}
+
+func (x *ListPatientsForDoctorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatientsForDoctorRequest.ProtoReflect.Descriptor instead.
+func (*ListPatientsForDoctorRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListPatientsForDoctorRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *ListPatientsForDoctorRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPatientsForDoctorRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListPatientsForDoctorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Patients   []*DoctorPatient `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	NextCursor string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListPatientsForDoctorResponse) Reset() {
+	*x = ListPatientsForDoctorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPatientsForDoctorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPatientsForDoctorResponse) ProtoMessage() {}
+
+func (x *ListPatientsForDoctorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPatientsForDoctorResponse.ProtoReflect.Descriptor instead.
+func (*ListPatientsForDoctorResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListPatientsForDoctorResponse) GetPatients() []*DoctorPatient {
+	if x != nil {
+		return x.Patients
+	}
+	return nil
+}
+
+func (x *ListPatientsForDoctorResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// ListMedicalRecordsByDoctor doctor_id si shu shifokor bo'lgan va bemori
+// rozilik bergan yozuvlar
+type ListMedicalRecordsByDoctorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DoctorId string `protobuf:"bytes,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	// bo'sh bo'lmasa faqat shu bemorning yozuvlari
+	PatientId string `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Limit     int64  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor    string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ListMedicalRecordsByDoctorRequest) Reset() {
+	*x = ListMedicalRecordsByDoctorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMedicalRecordsByDoctorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsByDoctorRequest) ProtoMessage() {}
+
+func (x *ListMedicalRecordsByDoctorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsByDoctorRequest.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsByDoctorRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListMedicalRecordsByDoctorRequest) GetDoctorId() string {
+	if x != nil {
+		return x.DoctorId
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsByDoctorRequest) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
+}
+
+func (x *ListMedicalRecordsByDoctorRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMedicalRecordsByDoctorRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListMedicalRecordsByDoctorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MedicalRecords []*MedicalRecord `protobuf:"bytes,1,rep,name=medical_records,json=medicalRecords,proto3" json:"medical_records,omitempty"`
+	NextCursor     string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *ListMedicalRecordsByDoctorResponse) Reset() {
+	*x = ListMedicalRecordsByDoctorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMedicalRecordsByDoctorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMedicalRecordsByDoctorResponse) ProtoMessage() {}
+
+func (x *ListMedicalRecordsByDoctorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMedicalRecordsByDoctorResponse.ProtoReflect.Descriptor instead.
+func (*ListMedicalRecordsByDoctorResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListMedicalRecordsByDoctorResponse) GetMedicalRecords() []*MedicalRecord {
+	if x != nil {
+		return x.MedicalRecords
+	}
+	return nil
+}
+
+func (x *ListMedicalRecordsByDoctorResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// Audit log uchun message'lar
+//
+// AuditLogEntry tibbiy ma'lumotlarga bitta murojaat. hash oldingi yozuvning
+// hash i va shu yozuv maydonlaridan sha256 bilan hisoblanadi.
+type AuditLogEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq       int64  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Id        string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId   string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole string `protobuf:"bytes,4,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	// create, get, list, update, delete yoki restore
+	Action       string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	ResourceType string `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId   string `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// ma'lumotlari ko'rilgan yoki o'zgartirilgan foydalanuvchi
+	UserId    string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// gRPC metodi, mijoz manzili, user-agent va boshqalar
+	Metadata map[string]string `protobuf:"bytes,10,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PrevHash string            `protobuf:"bytes,11,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash     string            `protobuf:"bytes,12,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AuditLogEntry) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type QueryAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorId      string `protobuf:"bytes,1,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	UserId       string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ResourceType string `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceId   string `protobuf:"bytes,4,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	Action       string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// from kiradi, to kirmaydi
+	From   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=to,proto3" json:"to,omitempty"`
+	Limit  int64                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string                 `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *QueryAuditLogRequest) Reset() {
+	*x = QueryAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogRequest) ProtoMessage() {}
+
+func (x *QueryAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *QueryAuditLogRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *QueryAuditLogRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *QueryAuditLogRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryAuditLogRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type QueryAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries    []*AuditLogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor string           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (x *QueryAuditLogResponse) Reset() {
+	*x = QueryAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAuditLogResponse) ProtoMessage() {}
+
+func (x *QueryAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *QueryAuditLogResponse) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *QueryAuditLogResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type VerifyAuditLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 bo'lsa zanjir boshidan
+	FromSeq int64 `protobuf:"varint,1,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq,omitempty"`
+	// 0 bo'lsa oxirigacha
+	ToSeq int64 `protobuf:"varint,2,opt,name=to_seq,json=toSeq,proto3" json:"to_seq,omitempty"`
+}
+
+func (x *VerifyAuditLogRequest) Reset() {
+	*x = VerifyAuditLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogRequest) ProtoMessage() {}
+
+func (x *VerifyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *VerifyAuditLogRequest) GetFromSeq() int64 {
+	if x != nil {
+		return x.FromSeq
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogRequest) GetToSeq() int64 {
+	if x != nil {
+		return x.ToSeq
+	}
+	return 0
+}
+
+type VerifyAuditLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid   bool  `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Checked int64 `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	// valid false bo'lganda zanjir uzilgan birinchi seq
+	FirstInvalidSeq int64 `protobuf:"varint,3,opt,name=first_invalid_seq,json=firstInvalidSeq,proto3" json:"first_invalid_seq,omitempty"`
+}
+
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogResponse) ProtoMessage() {}
+
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *VerifyAuditLogResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditLogResponse) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetFirstInvalidSeq() int64 {
+	if x != nil {
+		return x.FirstInvalidSeq
+	}
+	return 0
+}
+
+// Dead-letter navbatlari uchun message'lar
+//
+// DeadLetter qayta ishlab bo'lmagan va "<queue>.dlq" ga tushgan xabar. Servis
+// ularni dead_letters kolleksiyasiga ko'chiradi, ro'yxat va replay shu yerdan.
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId string `protobuf:"bytes,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	// xabar kelgan asl navbat
+	Queue          string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Retries        int32                  `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty"`
+	DeadLetteredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=dead_lettered_at,json=deadLetteredAt,proto3" json:"dead_lettered_at,omitempty"`
+	Body           string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeadLetter) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetRetries() int32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetDeadLetteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeadLetteredAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queue string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ListDeadLettersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetters []*DeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+}
+
+func (x *ListDeadLettersResponse) Reset() {
+	*x = ListDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersResponse) ProtoMessage() {}
+
+func (x *ListDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListDeadLettersResponse) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+// ReplayDeadLettersRequest message_ids bo'sh bo'lsa limit tagacha hamma xabar qaytariladi
+type ReplayDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queue      string   `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	MessageIds []string `protobuf:"bytes,2,rep,name=message_ids,json=messageIds,proto3" json:"message_ids,omitempty"`
+	Limit      int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ReplayDeadLettersRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ReplayDeadLettersRequest) GetMessageIds() []string {
+	if x != nil {
+		return x.MessageIds
+	}
+	return nil
+}
+
+func (x *ReplayDeadLettersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replayed int64 `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() int64 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+// SetUserTimezoneRequest timezone IANA nomi, masalan "Asia/Tashkent". Bo'sh
+// bo'lsa saqlangan zona o'chiriladi va UTC ishlatiladi.
+type SetUserTimezoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *SetUserTimezoneRequest) Reset() {
+	*x = SetUserTimezoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserTimezoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTimezoneRequest) ProtoMessage() {}
+
+func (x *SetUserTimezoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTimezoneRequest.ProtoReflect.Descriptor instead.
+func (*SetUserTimezoneRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SetUserTimezoneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserTimezoneRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type SetUserTimezoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *SetUserTimezoneResponse) Reset() {
+	*x = SetUserTimezoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserTimezoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTimezoneResponse) ProtoMessage() {}
+
+func (x *SetUserTimezoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTimezoneResponse.ProtoReflect.Descriptor instead.
+func (*SetUserTimezoneResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SetUserTimezoneResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetUserTimezoneRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserTimezoneRequest) Reset() {
+	*x = GetUserTimezoneRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserTimezoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTimezoneRequest) ProtoMessage() {}
+
+func (x *GetUserTimezoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTimezoneRequest.ProtoReflect.Descriptor instead.
+func (*GetUserTimezoneRequest) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetUserTimezoneRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetUserTimezoneResponse timezone saqlanmagan bo'lsa bo'sh, updated_at esa nil
+type GetUserTimezoneResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timezone  string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *GetUserTimezoneResponse) Reset() {
+	*x = GetUserTimezoneResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserTimezoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTimezoneResponse) ProtoMessage() {}
+
+func (x *GetUserTimezoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTimezoneResponse.ProtoReflect.Descriptor instead.
+func (*GetUserTimezoneResponse) Descriptor() ([]byte, []int) {
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetUserTimezoneResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetUserTimezoneResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto protoreflect.FileDescriptor
+
+var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDesc = []byte{
+	0x0a, 0x41, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x5f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7a, 0x0a, 0x27, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x38, 0x0a, 0x26, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x77, 0x65,
+	0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xcf, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a,
+	0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaf, 0x02, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a,
+	0x04, 0x08, 0x07, 0x10, 0x08, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0b,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xe3, 0x03, 0x0a, 0x0d, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08,
+	0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x09,
+	0x10, 0x0a, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0xdb, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04,
+	0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x61, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x22, 0x37, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x37, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x19, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x40, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05,
+	0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xa7, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e,
+	0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x46, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x69, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x8d, 0x01,
+	0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0e, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xca, 0x01,
+	0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x76, 0x0a, 0x17, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x67, 0x0a, 0x14, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x57, 0x0a, 0x18, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x19, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x1a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x5b, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x34, 0x0a,
+	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0xbe, 0x03, 0x0a, 0x0d, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x05,
+	0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04,
+	0x08, 0x09, 0x10, 0x0a, 0x22, 0xf5, 0x01, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x60, 0x0a, 0x18,
+	0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0d, 0x6c, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x29,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x61, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x6c,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x88, 0x02, 0x0a,
+	0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x37, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x37,
+	0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x46, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x38, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x68, 0x0a, 0x1f, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x6c, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x0d, 0x6c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xe8, 0x03, 0x0a, 0x0c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07, 0x10, 0x08, 0x4a,
+	0x04, 0x08, 0x08, 0x10, 0x09, 0x4a, 0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0x9f, 0x02, 0x0a, 0x16,
+	0x41, 0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x49, 0x0a, 0x12,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x5c, 0x0a,
+	0x17, 0x41, 0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77,
+	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x62, 0x0a, 0x1b, 0x41,
+	0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x08, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x62, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5d, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c,
+	0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb2, 0x02, 0x0a,
+	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x61, 0x74, 0x61, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x49, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3e, 0x0a, 0x0b, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x07, 0x22, 0x36, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x45,
+	0x0a, 0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x67,
+	0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x57, 0x65, 0x61,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x77,
+	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xb0, 0x02, 0x0a, 0x14, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08,
+	0x07, 0x10, 0x08, 0x22, 0x93, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a, 0x04, 0x08, 0x04,
+	0x10, 0x05, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x78, 0x0a, 0x25, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xd0, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x40, 0x0a, 0x25, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x44, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x4f, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22,
+	0x4b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x90, 0x01, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x76, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xb8, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x4f, 0x0a, 0x0f, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x08, 0x03,
+	0x10, 0x04, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x52, 0x13,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x57, 0x0a, 0x1d, 0x47, 0x65,
+	0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x0c, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x76, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x76, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x76, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x76, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x82, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12,
+	0x34, 0x0a, 0x06, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x06, 0x74,
+	0x72, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
+	0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0xd4, 0x02, 0x0a, 0x0e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x04, 0x08, 0x07, 0x10,
+	0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x22, 0xcf, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5d, 0x0a, 0x1c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x35, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x5e, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22,
+	0xa9, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x38, 0x0a, 0x1c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xbf,
+	0x03, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x77, 0x65, 0x61,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d,
+	0x22, 0x8b, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x13, 0x75, 0x6e, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x75, 0x6e,
+	0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x65,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x52, 0x0a, 0x17, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x42, 0x79, 0x22, 0x34, 0x0a, 0x18, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
+	0x9c, 0x01, 0x0a, 0x0d, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x57,
+	0x0a, 0x19, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x1a, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x22,
+	0x58, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x1b, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x3a, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x58,
+	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0d, 0x44, 0x6f, 0x63,
+	0x74, 0x6f, 0x72, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x74, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x69, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x6f, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x6f, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x7c, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x74, 0x6f,
+	0x72, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x44, 0x6f, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x74, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x44, 0x6f, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x6d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x0e, 0x6d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0xda, 0x03, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x48, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x09, 0x10,
+	0x0a, 0x22, 0xbe, 0x02, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x4a, 0x04, 0x08, 0x07,
+	0x10, 0x08, 0x22, 0x72, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x49, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x6f,
+	0x5f, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x53, 0x65,
+	0x71, 0x22, 0x74, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x71,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x53, 0x65, 0x71, 0x22, 0xd3, 0x01, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a,
+	0x10, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x44, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x59, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e,
+	0x0a, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x52, 0x0b, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0x67,
+	0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x37, 0x0a, 0x19, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x22, 0x4d, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22,
+	0x33, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x31, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x34, 0x0a, 0x09, 0x53, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x2a,
+	0x6d, 0x0a, 0x16, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x25, 0x4d, 0x45, 0x44,
+	0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x41,
+	0x54, 0x45, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x4d, 0x45, 0x44, 0x49, 0x43, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x10, 0x01, 0x32, 0xe0,
+	0x2b, 0x0a, 0x16, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x41, 0x64, 0x64,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x31, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4d,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x12, 0x6f, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x67, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4c, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x70, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x41,
+	0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x65, 0x61,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x73, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x57,
+	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x65, 0x61,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57,
+	0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61,
+	0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x57, 0x65, 0x61, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1d, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x1f, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x12, 0x37,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x88, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d,
+	0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x33, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a,
+	0x1e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x36, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x6c, 0x74, 0x69,
+	0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12,
+	0x76, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x65, 0x6b, 0x6c, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x2e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x24, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x73, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x2b, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x22,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x28, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6d, 0x0a, 0x12, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x70, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x44,
+	0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x44, 0x6f, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x76, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73,
+	0x46, 0x6f, 0x72, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2d, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x6f, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42,
+	0x79, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x42, 0x79, 0x44, 0x6f,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x42, 0x79, 0x44, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x12, 0x25, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x12, 0x26, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x11, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x27, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x27,
+	0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x1b, 0x5a, 0x19, 0x67, 0x65, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x5f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescOnce sync.Once
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData = file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDesc
+)
+
+func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescGZIP() []byte {
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescOnce.Do(func() {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData = protoimpl.X.CompressGZIP(file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData)
+	})
+	return file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDescData
+}
+
+var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
+var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_goTypes = []any{
+	(SortOrder)(0),                                  // 0: healthanalytics.SortOrder
+	(MedicalRecordSortField)(0),                     // 1: healthanalytics.MedicalRecordSortField
+	(*GenerateHealthRecommendationsIdResponse)(nil), // 2: healthanalytics.GenerateHealthRecommendationsIdResponse
+	(*GenerateHealthRecommendationsIdRequest)(nil),  // 3: healthanalytics.GenerateHealthRecommendationsIdRequest
+	(*GetAllWearableDataResponse)(nil),              // 4: healthanalytics.GetAllWearableDataResponse
+	(*GetAllWearableDataRequest)(nil),               // 5: healthanalytics.GetAllWearableDataRequest
+	(*GetAllLifestyleDataResponse)(nil),             // 6: healthanalytics.GetAllLifestyleDataResponse
+	(*GetAllLifestyleDataRequest)(nil),              // 7: healthanalytics.GetAllLifestyleDataRequest
+	(*Measurement)(nil),                             // 8: healthanalytics.Measurement
+	(*MedicalRecord)(nil),                           // 9: healthanalytics.MedicalRecord
+	(*AddMedicalRecordRequest)(nil),                 // 10: healthanalytics.AddMedicalRecordRequest
+	(*AddMedicalRecordResponse)(nil),                // 11: healthanalytics.AddMedicalRecordResponse
+	(*GetMedicalRecordRequest)(nil),                 // 12: healthanalytics.GetMedicalRecordRequest
+	(*GetMedicalRecordResponse)(nil),                // 13: healthanalytics.GetMedicalRecordResponse
+	(*UpdateMedicalRecordRequest)(nil),              // 14: healthanalytics.UpdateMedicalRecordRequest
+	(*UpdateMedicalRecordResponse)(nil),             // 15: healthanalytics.UpdateMedicalRecordResponse
+	(*DeleteMedicalRecordRequest)(nil),              // 16: healthanalytics.DeleteMedicalRecordRequest
+	(*DeleteMedicalRecordResponse)(nil),             // 17: healthanalytics.DeleteMedicalRecordResponse
+	(*ListMedicalRecordsRequest)(nil),               // 18: healthanalytics.ListMedicalRecordsRequest
+	(*ListMedicalRecordsResponse)(nil),              // 19: healthanalytics.ListMedicalRecordsResponse
+	(*RestoreMedicalRecordRequest)(nil),             // 20: healthanalytics.RestoreMedicalRecordRequest
+	(*RestoreMedicalRecordResponse)(nil),            // 21: healthanalytics.RestoreMedicalRecordResponse
+	(*ListDeletedMedicalRecordsRequest)(nil),        // 22: healthanalytics.ListDeletedMedicalRecordsRequest
+	(*ListDeletedMedicalRecordsResponse)(nil),       // 23: healthanalytics.ListDeletedMedicalRecordsResponse
+	(*Attachment)(nil),                              // 24: healthanalytics.Attachment
+	(*UploadAttachmentRequest)(nil),                 // 25: healthanalytics.UploadAttachmentRequest
+	(*AttachmentUploadInfo)(nil),                    // 26: healthanalytics.AttachmentUploadInfo
+	(*UploadAttachmentResponse)(nil),                // 27: healthanalytics.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),               // 28: healthanalytics.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),              // 29: healthanalytics.DownloadAttachmentResponse
+	(*DeleteAttachmentRequest)(nil),                 // 30: healthanalytics.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),                // 31: healthanalytics.DeleteAttachmentResponse
+	(*LifestyleData)(nil),                           // 32: healthanalytics.LifestyleData
+	(*AddLifestyleDataRequest)(nil),                 // 33: healthanalytics.AddLifestyleDataRequest
+	(*AddLifestyleDataResponse)(nil),                // 34: healthanalytics.AddLifestyleDataResponse
+	(*GetLifestyleDataRequest)(nil),                 // 35: healthanalytics.GetLifestyleDataRequest
+	(*GetLifestyleDataResponse)(nil),                // 36: healthanalytics.GetLifestyleDataResponse
+	(*UpdateLifestyleDataRequest)(nil),              // 37: healthanalytics.UpdateLifestyleDataRequest
+	(*UpdateLifestyleDataResponse)(nil),             // 38: healthanalytics.UpdateLifestyleDataResponse
+	(*DeleteLifestyleDataRequest)(nil),              // 39: healthanalytics.DeleteLifestyleDataRequest
+	(*DeleteLifestyleDataResponse)(nil),             // 40: healthanalytics.DeleteLifestyleDataResponse
+	(*RestoreLifestyleDataRequest)(nil),             // 41: healthanalytics.RestoreLifestyleDataRequest
+	(*RestoreLifestyleDataResponse)(nil),            // 42: healthanalytics.RestoreLifestyleDataResponse
+	(*ListDeletedLifestyleDataRequest)(nil),         // 43: healthanalytics.ListDeletedLifestyleDataRequest
+	(*ListDeletedLifestyleDataResponse)(nil),        // 44: healthanalytics.ListDeletedLifestyleDataResponse
+	(*WearableData)(nil),                            // 45: healthanalytics.WearableData
+	(*AddWearableDataRequest)(nil),                  // 46: healthanalytics.AddWearableDataRequest
+	(*AddWearableDataResponse)(nil),                 // 47: healthanalytics.AddWearableDataResponse
+	(*AddWearableDataBatchRequest)(nil),             // 48: healthanalytics.AddWearableDataBatchRequest
+	(*AddWearableDataBatchResponse)(nil),            // 49: healthanalytics.AddWearableDataBatchResponse
+	(*GetWearableDataRequest)(nil),                  // 50: healthanalytics.GetWearableDataRequest
+	(*GetWearableDataResponse)(nil),                 // 51: healthanalytics.GetWearableDataResponse
+	(*UpdateWearableDataRequest)(nil),               // 52: healthanalytics.UpdateWearableDataRequest
+	(*UpdateWearableDataResponse)(nil),              // 53: healthanalytics.UpdateWearableDataResponse
+	(*DeleteWearableDataRequest)(nil),               // 54: healthanalytics.DeleteWearableDataRequest
+	(*DeleteWearableDataResponse)(nil),              // 55: healthanalytics.DeleteWearableDataResponse
+	(*RestoreWearableDataRequest)(nil),              // 56: healthanalytics.RestoreWearableDataRequest
+	(*RestoreWearableDataResponse)(nil),             // 57: healthanalytics.RestoreWearableDataResponse
+	(*ListDeletedWearableDataRequest)(nil),          // 58: healthanalytics.ListDeletedWearableDataRequest
+	(*ListDeletedWearableDataResponse)(nil),         // 59: healthanalytics.ListDeletedWearableDataResponse
+	(*HealthRecommendation)(nil),                    // 60: healthanalytics.HealthRecommendation
+	(*GenerateHealthRecommendationsRequest)(nil),    // 61: healthanalytics.GenerateHealthRecommendationsRequest
+	(*GenerateHealthRecommendationsResponse)(nil),   // 62: healthanalytics.GenerateHealthRecommendationsResponse
+	(*GetRealtimeHealthMonitoringRequest)(nil),      // 63: healthanalytics.GetRealtimeHealthMonitoringRequest
+	(*GetRealtimeHealthMonitoringResponse)(nil),     // 64: healthanalytics.GetRealtimeHealthMonitoringResponse
+	(*StreamRealtimeHealthMonitoringRequest)(nil),   // 65: healthanalytics.StreamRealtimeHealthMonitoringRequest
+	(*RealtimeHealthEvent)(nil),                     // 66: healthanalytics.RealtimeHealthEvent
+	(*GetDailyHealthSummaryRequest)(nil),            // 67: healthanalytics.GetDailyHealthSummaryRequest
+	(*MetricSummary)(nil),                           // 68: healthanalytics.MetricSummary
+	(*GetDailyHealthSummaryResponse)(nil),           // 69: healthanalytics.GetDailyHealthSummaryResponse
+	(*GetWeeklyHealthSummaryRequest)(nil),           // 70: healthanalytics.GetWeeklyHealthSummaryRequest
+	(*DailyMetrics)(nil),                            // 71: healthanalytics.DailyMetrics
+	(*MetricTrend)(nil),                             // 72: healthanalytics.MetricTrend
+	(*GetWeeklyHealthSummaryResponse)(nil),          // 73: healthanalytics.GetWeeklyHealthSummaryResponse
+	(*UserIDHealthRequest)(nil),                     // 74: healthanalytics.UserIDHealthRequest
+	(*UserIDHealthResponse)(nil),                    // 75: healthanalytics.UserIDHealthResponse
+	(*AlertThreshold)(nil),                          // 76: healthanalytics.AlertThreshold
+	(*CreateAlertThresholdRequest)(nil),             // 77: healthanalytics.CreateAlertThresholdRequest
+	(*CreateAlertThresholdResponse)(nil),            // 78: healthanalytics.CreateAlertThresholdResponse
+	(*ListAlertThresholdsRequest)(nil),              // 79: healthanalytics.ListAlertThresholdsRequest
+	(*ListAlertThresholdsResponse)(nil),             // 80: healthanalytics.ListAlertThresholdsResponse
+	(*UpdateAlertThresholdRequest)(nil),             // 81: healthanalytics.UpdateAlertThresholdRequest
+	(*UpdateAlertThresholdResponse)(nil),            // 82: healthanalytics.UpdateAlertThresholdResponse
+	(*DeleteAlertThresholdRequest)(nil),             // 83: healthanalytics.DeleteAlertThresholdRequest
+	(*DeleteAlertThresholdResponse)(nil),            // 84: healthanalytics.DeleteAlertThresholdResponse
+	(*Alert)(nil),                                   // 85: healthanalytics.Alert
+	(*ListAlertsRequest)(nil),                       // 86: healthanalytics.ListAlertsRequest
+	(*ListAlertsResponse)(nil),                      // 87: healthanalytics.ListAlertsResponse
+	(*AcknowledgeAlertRequest)(nil),                 // 88: healthanalytics.AcknowledgeAlertRequest
+	(*AcknowledgeAlertResponse)(nil),                // 89: healthanalytics.AcknowledgeAlertResponse
+	(*DoctorConsent)(nil),                           // 90: healthanalytics.DoctorConsent
+	(*GrantDoctorConsentRequest)(nil),               // 91: healthanalytics.GrantDoctorConsentRequest
+	(*GrantDoctorConsentResponse)(nil),              // 92: healthanalytics.GrantDoctorConsentResponse
+	(*RevokeDoctorConsentRequest)(nil),              // 93: healthanalytics.RevokeDoctorConsentRequest
+	(*RevokeDoctorConsentResponse)(nil),             // 94: healthanalytics.RevokeDoctorConsentResponse
+	(*ListDoctorConsentsRequest)(nil),               // 95: healthanalytics.ListDoctorConsentsRequest
+	(*ListDoctorConsentsResponse)(nil),              // 96: healthanalytics.ListDoctorConsentsResponse
+	(*DoctorPatient)(nil),                           // 97: healthanalytics.DoctorPatient
+	(*ListPatientsForDoctorRequest)(nil),            // 98: healthanalytics.ListPatientsForDoctorRequest
+	(*ListPatientsForDoctorResponse)(nil),           // 99: healthanalytics.ListPatientsForDoctorResponse
+	(*ListMedicalRecordsByDoctorRequest)(nil),       // 100: healthanalytics.ListMedicalRecordsByDoctorRequest
+	(*ListMedicalRecordsByDoctorResponse)(nil),      // 101: healthanalytics.ListMedicalRecordsByDoctorResponse
+	(*AuditLogEntry)(nil),                           // 102: healthanalytics.AuditLogEntry
+	(*QueryAuditLogRequest)(nil),                    // 103: healthanalytics.QueryAuditLogRequest
+	(*QueryAuditLogResponse)(nil),                   // 104: healthanalytics.QueryAuditLogResponse
+	(*VerifyAuditLogRequest)(nil),                   // 105: healthanalytics.VerifyAuditLogRequest
+	(*VerifyAuditLogResponse)(nil),                  // 106: healthanalytics.VerifyAuditLogResponse
+	(*DeadLetter)(nil),                              // 107: healthanalytics.DeadLetter
+	(*ListDeadLettersRequest)(nil),                  // 108: healthanalytics.ListDeadLettersRequest
+	(*ListDeadLettersResponse)(nil),                 // 109: healthanalytics.ListDeadLettersResponse
+	(*ReplayDeadLettersRequest)(nil),                // 110: healthanalytics.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),               // 111: healthanalytics.ReplayDeadLettersResponse
+	(*SetUserTimezoneRequest)(nil),                  // 112: healthanalytics.SetUserTimezoneRequest
+	(*SetUserTimezoneResponse)(nil),                 // 113: healthanalytics.SetUserTimezoneResponse
+	(*GetUserTimezoneRequest)(nil),                  // 114: healthanalytics.GetUserTimezoneRequest
+	(*GetUserTimezoneResponse)(nil),                 // 115: healthanalytics.GetUserTimezoneResponse
+	nil,                                             // 116: healthanalytics.Measurement.ComponentsEntry
+	nil,                                             // 117: healthanalytics.AuditLogEntry.MetadataEntry
+	(*timestamppb.Timestamp)(nil),                   // 118: google.protobuf.Timestamp
+}
+var file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_depIdxs = []int32{
+	60,  // 0: healthanalytics.GenerateHealthRecommendationsIdResponse.recommendations:type_name -> healthanalytics.HealthRecommendation
+	45,  // 1: healthanalytics.GetAllWearableDataResponse.wearabledata:type_name -> healthanalytics.WearableData
+	118, // 2: healthanalytics.GetAllWearableDataRequest.from:type_name -> google.protobuf.Timestamp
+	118, // 3: healthanalytics.GetAllWearableDataRequest.to:type_name -> google.protobuf.Timestamp
+	0,   // 4: healthanalytics.GetAllWearableDataRequest.sort_order:type_name -> healthanalytics.SortOrder
+	32,  // 5: healthanalytics.GetAllLifestyleDataResponse.lifestyledata:type_name -> healthanalytics.LifestyleData
+	118, // 6: healthanalytics.GetAllLifestyleDataRequest.from:type_name -> google.protobuf.Timestamp
+	118, // 7: healthanalytics.GetAllLifestyleDataRequest.to:type_name -> google.protobuf.Timestamp
+	0,   // 8: healthanalytics.GetAllLifestyleDataRequest.sort_order:type_name -> healthanalytics.SortOrder
+	116, // 9: healthanalytics.Measurement.components:type_name -> healthanalytics.Measurement.ComponentsEntry
+	118, // 10: healthanalytics.MedicalRecord.record_date:type_name -> google.protobuf.Timestamp
+	118, // 11: healthanalytics.MedicalRecord.created_at:type_name -> google.protobuf.Timestamp
+	118, // 12: healthanalytics.MedicalRecord.updated_at:type_name -> google.protobuf.Timestamp
+	118, // 13: healthanalytics.MedicalRecord.deleted_at:type_name -> google.protobuf.Timestamp
+	24,  // 14: healthanalytics.MedicalRecord.attachments:type_name -> healthanalytics.Attachment
+	118, // 15: healthanalytics.AddMedicalRecordRequest.record_date:type_name -> google.protobuf.Timestamp
+	9,   // 16: healthanalytics.AddMedicalRecordResponse.medical_record:type_name -> healthanalytics.MedicalRecord
+	9,   // 17: healthanalytics.GetMedicalRecordResponse.medical_record:type_name -> healthanalytics.MedicalRecord
+	118, // 18: healthanalytics.UpdateMedicalRecordRequest.record_date:type_name -> google.protobuf.Timestamp
+	118, // 19: healthanalytics.ListMedicalRecordsRequest.from:type_name -> google.protobuf.Timestamp
+	118, // 20: healthanalytics.ListMedicalRecordsRequest.to:type_name -> google.protobuf.Timestamp
+	1,   // 21: healthanalytics.ListMedicalRecordsRequest.sort_by:type_name -> healthanalytics.MedicalRecordSortField
+	0,   // 22: healthanalytics.ListMedicalRecordsRequest.sort_order:type_name -> healthanalytics.SortOrder
+	9,   // 23: healthanalytics.ListMedicalRecordsResponse.medical_records:type_name -> healthanalytics.MedicalRecord
+	9,   // 24: healthanalytics.ListDeletedMedicalRecordsResponse.medical_records:type_name -> healthanalytics.MedicalRecord
+	118, // 25: healthanalytics.Attachment.uploaded_at:type_name -> google.protobuf.Timestamp
+	26,  // 26: healthanalytics.UploadAttachmentRequest.info:type_name -> healthanalytics.AttachmentUploadInfo
+	24,  // 27: healthanalytics.UploadAttachmentResponse.attachment:type_name -> healthanalytics.Attachment
+	24,  // 28: healthanalytics.DownloadAttachmentResponse.attachment:type_name -> healthanalytics.Attachment
+	118, // 29: healthanalytics.LifestyleData.recorded_date:type_name -> google.protobuf.Timestamp
+	118, // 30: healthanalytics.LifestyleData.created_at:type_name -> google.protobuf.Timestamp
+	118, // 31: healthanalytics.LifestyleData.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 32: healthanalytics.LifestyleData.measurement:type_name -> healthanalytics.Measurement
+	118, // 33: healthanalytics.LifestyleData.deleted_at:type_name -> google.protobuf.Timestamp
+	118, // 34: healthanalytics.AddLifestyleDataRequest.recorded_date:type_name -> google.protobuf.Timestamp
+	8,   // 35: healthanalytics.AddLifestyleDataRequest.measurement:type_name -> healthanalytics.Measurement
+	32,  // 36: healthanalytics.AddLifestyleDataResponse.lifestyleData:type_name -> healthanalytics.LifestyleData
+	32,  // 37: healthanalytics.GetLifestyleDataResponse.lifestyle_data:type_name -> healthanalytics.LifestyleData
+	118, // 38: healthanalytics.UpdateLifestyleDataRequest.recorded_date:type_name -> google.protobuf.Timestamp
+	8,   // 39: healthanalytics.UpdateLifestyleDataRequest.measurement:type_name -> healthanalytics.Measurement
+	32,  // 40: healthanalytics.ListDeletedLifestyleDataResponse.lifestyle_data:type_name -> healthanalytics.LifestyleData
+	118, // 41: healthanalytics.WearableData.recorded_timestamp:type_name -> google.protobuf.Timestamp
+	118, // 42: healthanalytics.WearableData.created_at:type_name -> google.protobuf.Timestamp
+	118, // 43: healthanalytics.WearableData.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 44: healthanalytics.WearableData.measurement:type_name -> healthanalytics.Measurement
+	118, // 45: healthanalytics.WearableData.deleted_at:type_name -> google.protobuf.Timestamp
+	118, // 46: healthanalytics.AddWearableDataRequest.recorded_timestamp:type_name -> google.protobuf.Timestamp
+	8,   // 47: healthanalytics.AddWearableDataRequest.measurement:type_name -> healthanalytics.Measurement
+	45,  // 48: healthanalytics.AddWearableDataResponse.wearableData:type_name -> healthanalytics.WearableData
+	46,  // 49: healthanalytics.AddWearableDataBatchRequest.readings:type_name -> healthanalytics.AddWearableDataRequest
+	45,  // 50: healthanalytics.AddWearableDataBatchResponse.wearable_data:type_name -> healthanalytics.WearableData
+	45,  // 51: healthanalytics.GetWearableDataResponse.wearable_data:type_name -> healthanalytics.WearableData
+	118, // 52: healthanalytics.UpdateWearableDataRequest.recorded_timestamp:type_name -> google.protobuf.Timestamp
+	8,   // 53: healthanalytics.UpdateWearableDataRequest.measurement:type_name -> healthanalytics.Measurement
+	45,  // 54: healthanalytics.ListDeletedWearableDataResponse.wearable_data:type_name -> healthanalytics.WearableData
+	118, // 55: healthanalytics.HealthRecommendation.created_at:type_name -> google.protobuf.Timestamp
+	118, // 56: healthanalytics.HealthRecommendation.updated_at:type_name -> google.protobuf.Timestamp
+	60,  // 57: healthanalytics.GenerateHealthRecommendationsResponse.recommendations:type_name -> healthanalytics.HealthRecommendation
+	45,  // 58: healthanalytics.RealtimeHealthEvent.wearable_data:type_name -> healthanalytics.WearableData
+	60,  // 59: healthanalytics.RealtimeHealthEvent.recommendation:type_name -> healthanalytics.HealthRecommendation
+	85,  // 60: healthanalytics.RealtimeHealthEvent.alert:type_name -> healthanalytics.Alert
+	68,  // 61: healthanalytics.GetDailyHealthSummaryResponse.metrics:type_name -> healthanalytics.MetricSummary
+	60,  // 62: healthanalytics.GetDailyHealthSummaryResponse.recommendations:type_name -> healthanalytics.HealthRecommendation
+	68,  // 63: healthanalytics.DailyMetrics.metrics:type_name -> healthanalytics.MetricSummary
+	60,  // 64: healthanalytics.GetWeeklyHealthSummaryResponse.health:type_name -> healthanalytics.HealthRecommendation
+	71,  // 65: healthanalytics.GetWeeklyHealthSummaryResponse.days:type_name -> healthanalytics.DailyMetrics
+	72,  // 66: healthanalytics.GetWeeklyHealthSummaryResponse.trends:type_name -> healthanalytics.MetricTrend
+	60,  // 67: healthanalytics.UserIDHealthResponse.recommendations:type_name -> healthanalytics.HealthRecommendation
+	118, // 68: healthanalytics.AlertThreshold.created_at:type_name -> google.protobuf.Timestamp
+	118, // 69: healthanalytics.AlertThreshold.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 70: healthanalytics.CreateAlertThresholdResponse.threshold:type_name -> healthanalytics.AlertThreshold
+	76,  // 71: healthanalytics.ListAlertThresholdsResponse.thresholds:type_name -> healthanalytics.AlertThreshold
+	118, // 72: healthanalytics.Alert.acknowledged_at:type_name -> google.protobuf.Timestamp
+	118, // 73: healthanalytics.Alert.created_at:type_name -> google.protobuf.Timestamp
+	85,  // 74: healthanalytics.ListAlertsResponse.alerts:type_name -> healthanalytics.Alert
+	118, // 75: healthanalytics.DoctorConsent.created_at:type_name -> google.protobuf.Timestamp
+	90,  // 76: healthanalytics.GrantDoctorConsentResponse.consent:type_name -> healthanalytics.DoctorConsent
+	90,  // 77: healthanalytics.ListDoctorConsentsResponse.consents:type_name -> healthanalytics.DoctorConsent
+	118, // 78: healthanalytics.DoctorPatient.consented_at:type_name -> google.protobuf.Timestamp
+	97,  // 79: healthanalytics.ListPatientsForDoctorResponse.patients:type_name -> healthanalytics.DoctorPatient
+	9,   // 80: healthanalytics.ListMedicalRecordsByDoctorResponse.medical_records:type_name -> healthanalytics.MedicalRecord
+	118, // 81: healthanalytics.AuditLogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	117, // 82: healthanalytics.AuditLogEntry.metadata:type_name -> healthanalytics.AuditLogEntry.MetadataEntry
+	118, // 83: healthanalytics.QueryAuditLogRequest.from:type_name -> google.protobuf.Timestamp
+	118, // 84: healthanalytics.QueryAuditLogRequest.to:type_name -> google.protobuf.Timestamp
+	102, // 85: healthanalytics.QueryAuditLogResponse.entries:type_name -> healthanalytics.AuditLogEntry
+	118, // 86: healthanalytics.DeadLetter.dead_lettered_at:type_name -> google.protobuf.Timestamp
+	107, // 87: healthanalytics.ListDeadLettersResponse.dead_letters:type_name -> healthanalytics.DeadLetter
+	118, // 88: healthanalytics.GetUserTimezoneResponse.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 89: healthanalytics.HealthAnalyticsService.AddMedicalRecord:input_type -> healthanalytics.AddMedicalRecordRequest
+	12,  // 90: healthanalytics.HealthAnalyticsService.GetMedicalRecord:input_type -> healthanalytics.GetMedicalRecordRequest
+	14,  // 91: healthanalytics.HealthAnalyticsService.UpdateMedicalRecord:input_type -> healthanalytics.UpdateMedicalRecordRequest
+	16,  // 92: healthanalytics.HealthAnalyticsService.DeleteMedicalRecord:input_type -> healthanalytics.DeleteMedicalRecordRequest
+	18,  // 93: healthanalytics.HealthAnalyticsService.ListMedicalRecords:input_type -> healthanalytics.ListMedicalRecordsRequest
+	20,  // 94: healthanalytics.HealthAnalyticsService.RestoreMedicalRecord:input_type -> healthanalytics.RestoreMedicalRecordRequest
+	22,  // 95: healthanalytics.HealthAnalyticsService.ListDeletedMedicalRecords:input_type -> healthanalytics.ListDeletedMedicalRecordsRequest
+	25,  // 96: healthanalytics.HealthAnalyticsService.UploadAttachment:input_type -> healthanalytics.UploadAttachmentRequest
+	28,  // 97: healthanalytics.HealthAnalyticsService.DownloadAttachment:input_type -> healthanalytics.DownloadAttachmentRequest
+	30,  // 98: healthanalytics.HealthAnalyticsService.DeleteAttachment:input_type -> healthanalytics.DeleteAttachmentRequest
+	33,  // 99: healthanalytics.HealthAnalyticsService.AddLifestyleData:input_type -> healthanalytics.AddLifestyleDataRequest
+	35,  // 100: healthanalytics.HealthAnalyticsService.GetLifestyleData:input_type -> healthanalytics.GetLifestyleDataRequest
+	7,   // 101: healthanalytics.HealthAnalyticsService.GetAllLifestyleData:input_type -> healthanalytics.GetAllLifestyleDataRequest
+	37,  // 102: healthanalytics.HealthAnalyticsService.UpdateLifestyleData:input_type -> healthanalytics.UpdateLifestyleDataRequest
+	39,  // 103: healthanalytics.HealthAnalyticsService.DeleteLifestyleData:input_type -> healthanalytics.DeleteLifestyleDataRequest
+	41,  // 104: healthanalytics.HealthAnalyticsService.RestoreLifestyleData:input_type -> healthanalytics.RestoreLifestyleDataRequest
+	43,  // 105: healthanalytics.HealthAnalyticsService.ListDeletedLifestyleData:input_type -> healthanalytics.ListDeletedLifestyleDataRequest
+	46,  // 106: healthanalytics.HealthAnalyticsService.AddWearableData:input_type -> healthanalytics.AddWearableDataRequest
+	48,  // 107: healthanalytics.HealthAnalyticsService.AddWearableDataBatch:input_type -> healthanalytics.AddWearableDataBatchRequest
+	50,  // 108: healthanalytics.HealthAnalyticsService.GetWearableData:input_type -> healthanalytics.GetWearableDataRequest
+	5,   // 109: healthanalytics.HealthAnalyticsService.GetAllWearableData:input_type -> healthanalytics.GetAllWearableDataRequest
+	52,  // 110: healthanalytics.HealthAnalyticsService.UpdateWearableData:input_type -> healthanalytics.UpdateWearableDataRequest
+	54,  // 111: healthanalytics.HealthAnalyticsService.DeleteWearableData:input_type -> healthanalytics.DeleteWearableDataRequest
+	56,  // 112: healthanalytics.HealthAnalyticsService.RestoreWearableData:input_type -> healthanalytics.RestoreWearableDataRequest
+	58,  // 113: healthanalytics.HealthAnalyticsService.ListDeletedWearableData:input_type -> healthanalytics.ListDeletedWearableDataRequest
+	61,  // 114: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendations:input_type -> healthanalytics.GenerateHealthRecommendationsRequest
+	3,   // 115: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendationsId:input_type -> healthanalytics.GenerateHealthRecommendationsIdRequest
+	63,  // 116: healthanalytics.HealthAnalyticsService.GetRealtimeHealthMonitoring:input_type -> healthanalytics.GetRealtimeHealthMonitoringRequest
+	65,  // 117: healthanalytics.HealthAnalyticsService.StreamRealtimeHealthMonitoring:input_type -> healthanalytics.StreamRealtimeHealthMonitoringRequest
+	67,  // 118: healthanalytics.HealthAnalyticsService.GetDailyHealthSummary:input_type -> healthanalytics.GetDailyHealthSummaryRequest
+	70,  // 119: healthanalytics.HealthAnalyticsService.GetWeeklyHealthSummary:input_type -> healthanalytics.GetWeeklyHealthSummaryRequest
+	74,  // 120: healthanalytics.HealthAnalyticsService.UserIDHealth:input_type -> healthanalytics.UserIDHealthRequest
+	77,  // 121: healthanalytics.HealthAnalyticsService.CreateAlertThreshold:input_type -> healthanalytics.CreateAlertThresholdRequest
+	79,  // 122: healthanalytics.HealthAnalyticsService.ListAlertThresholds:input_type -> healthanalytics.ListAlertThresholdsRequest
+	81,  // 123: healthanalytics.HealthAnalyticsService.UpdateAlertThreshold:input_type -> healthanalytics.UpdateAlertThresholdRequest
+	83,  // 124: healthanalytics.HealthAnalyticsService.DeleteAlertThreshold:input_type -> healthanalytics.DeleteAlertThresholdRequest
+	86,  // 125: healthanalytics.HealthAnalyticsService.ListAlerts:input_type -> healthanalytics.ListAlertsRequest
+	88,  // 126: healthanalytics.HealthAnalyticsService.AcknowledgeAlert:input_type -> healthanalytics.AcknowledgeAlertRequest
+	91,  // 127: healthanalytics.HealthAnalyticsService.GrantDoctorConsent:input_type -> healthanalytics.GrantDoctorConsentRequest
+	93,  // 128: healthanalytics.HealthAnalyticsService.RevokeDoctorConsent:input_type -> healthanalytics.RevokeDoctorConsentRequest
+	95,  // 129: healthanalytics.HealthAnalyticsService.ListDoctorConsents:input_type -> healthanalytics.ListDoctorConsentsRequest
+	98,  // 130: healthanalytics.HealthAnalyticsService.ListPatientsForDoctor:input_type -> healthanalytics.ListPatientsForDoctorRequest
+	100, // 131: healthanalytics.HealthAnalyticsService.ListMedicalRecordsByDoctor:input_type -> healthanalytics.ListMedicalRecordsByDoctorRequest
+	103, // 132: healthanalytics.HealthAnalyticsService.QueryAuditLog:input_type -> healthanalytics.QueryAuditLogRequest
+	105, // 133: healthanalytics.HealthAnalyticsService.VerifyAuditLog:input_type -> healthanalytics.VerifyAuditLogRequest
+	108, // 134: healthanalytics.HealthAnalyticsService.ListDeadLetters:input_type -> healthanalytics.ListDeadLettersRequest
+	110, // 135: healthanalytics.HealthAnalyticsService.ReplayDeadLetters:input_type -> healthanalytics.ReplayDeadLettersRequest
+	112, // 136: healthanalytics.HealthAnalyticsService.SetUserTimezone:input_type -> healthanalytics.SetUserTimezoneRequest
+	114, // 137: healthanalytics.HealthAnalyticsService.GetUserTimezone:input_type -> healthanalytics.GetUserTimezoneRequest
+	11,  // 138: healthanalytics.HealthAnalyticsService.AddMedicalRecord:output_type -> healthanalytics.AddMedicalRecordResponse
+	13,  // 139: healthanalytics.HealthAnalyticsService.GetMedicalRecord:output_type -> healthanalytics.GetMedicalRecordResponse
+	15,  // 140: healthanalytics.HealthAnalyticsService.UpdateMedicalRecord:output_type -> healthanalytics.UpdateMedicalRecordResponse
+	17,  // 141: healthanalytics.HealthAnalyticsService.DeleteMedicalRecord:output_type -> healthanalytics.DeleteMedicalRecordResponse
+	19,  // 142: healthanalytics.HealthAnalyticsService.ListMedicalRecords:output_type -> healthanalytics.ListMedicalRecordsResponse
+	21,  // 143: healthanalytics.HealthAnalyticsService.RestoreMedicalRecord:output_type -> healthanalytics.RestoreMedicalRecordResponse
+	23,  // 144: healthanalytics.HealthAnalyticsService.ListDeletedMedicalRecords:output_type -> healthanalytics.ListDeletedMedicalRecordsResponse
+	27,  // 145: healthanalytics.HealthAnalyticsService.UploadAttachment:output_type -> healthanalytics.UploadAttachmentResponse
+	29,  // 146: healthanalytics.HealthAnalyticsService.DownloadAttachment:output_type -> healthanalytics.DownloadAttachmentResponse
+	31,  // 147: healthanalytics.HealthAnalyticsService.DeleteAttachment:output_type -> healthanalytics.DeleteAttachmentResponse
+	34,  // 148: healthanalytics.HealthAnalyticsService.AddLifestyleData:output_type -> healthanalytics.AddLifestyleDataResponse
+	36,  // 149: healthanalytics.HealthAnalyticsService.GetLifestyleData:output_type -> healthanalytics.GetLifestyleDataResponse
+	6,   // 150: healthanalytics.HealthAnalyticsService.GetAllLifestyleData:output_type -> healthanalytics.GetAllLifestyleDataResponse
+	38,  // 151: healthanalytics.HealthAnalyticsService.UpdateLifestyleData:output_type -> healthanalytics.UpdateLifestyleDataResponse
+	40,  // 152: healthanalytics.HealthAnalyticsService.DeleteLifestyleData:output_type -> healthanalytics.DeleteLifestyleDataResponse
+	42,  // 153: healthanalytics.HealthAnalyticsService.RestoreLifestyleData:output_type -> healthanalytics.RestoreLifestyleDataResponse
+	44,  // 154: healthanalytics.HealthAnalyticsService.ListDeletedLifestyleData:output_type -> healthanalytics.ListDeletedLifestyleDataResponse
+	47,  // 155: healthanalytics.HealthAnalyticsService.AddWearableData:output_type -> healthanalytics.AddWearableDataResponse
+	49,  // 156: healthanalytics.HealthAnalyticsService.AddWearableDataBatch:output_type -> healthanalytics.AddWearableDataBatchResponse
+	51,  // 157: healthanalytics.HealthAnalyticsService.GetWearableData:output_type -> healthanalytics.GetWearableDataResponse
+	4,   // 158: healthanalytics.HealthAnalyticsService.GetAllWearableData:output_type -> healthanalytics.GetAllWearableDataResponse
+	53,  // 159: healthanalytics.HealthAnalyticsService.UpdateWearableData:output_type -> healthanalytics.UpdateWearableDataResponse
+	55,  // 160: healthanalytics.HealthAnalyticsService.DeleteWearableData:output_type -> healthanalytics.DeleteWearableDataResponse
+	57,  // 161: healthanalytics.HealthAnalyticsService.RestoreWearableData:output_type -> healthanalytics.RestoreWearableDataResponse
+	59,  // 162: healthanalytics.HealthAnalyticsService.ListDeletedWearableData:output_type -> healthanalytics.ListDeletedWearableDataResponse
+	62,  // 163: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendations:output_type -> healthanalytics.GenerateHealthRecommendationsResponse
+	2,   // 164: healthanalytics.HealthAnalyticsService.GenerateHealthRecommendationsId:output_type -> healthanalytics.GenerateHealthRecommendationsIdResponse
+	64,  // 165: healthanalytics.HealthAnalyticsService.GetRealtimeHealthMonitoring:output_type -> healthanalytics.GetRealtimeHealthMonitoringResponse
+	66,  // 166: healthanalytics.HealthAnalyticsService.StreamRealtimeHealthMonitoring:output_type -> healthanalytics.RealtimeHealthEvent
+	69,  // 167: healthanalytics.HealthAnalyticsService.GetDailyHealthSummary:output_type -> healthanalytics.GetDailyHealthSummaryResponse
+	73,  // 168: healthanalytics.HealthAnalyticsService.GetWeeklyHealthSummary:output_type -> healthanalytics.GetWeeklyHealthSummaryResponse
+	75,  // 169: healthanalytics.HealthAnalyticsService.UserIDHealth:output_type -> healthanalytics.UserIDHealthResponse
+	78,  // 170: healthanalytics.HealthAnalyticsService.CreateAlertThreshold:output_type -> healthanalytics.CreateAlertThresholdResponse
+	80,  // 171: healthanalytics.HealthAnalyticsService.ListAlertThresholds:output_type -> healthanalytics.ListAlertThresholdsResponse
+	82,  // 172: healthanalytics.HealthAnalyticsService.UpdateAlertThreshold:output_type -> healthanalytics.UpdateAlertThresholdResponse
+	84,  // 173: healthanalytics.HealthAnalyticsService.DeleteAlertThreshold:output_type -> healthanalytics.DeleteAlertThresholdResponse
+	87,  // 174: healthanalytics.HealthAnalyticsService.ListAlerts:output_type -> healthanalytics.ListAlertsResponse
+	89,  // 175: healthanalytics.HealthAnalyticsService.AcknowledgeAlert:output_type -> healthanalytics.AcknowledgeAlertResponse
+	92,  // 176: healthanalytics.HealthAnalyticsService.GrantDoctorConsent:output_type -> healthanalytics.GrantDoctorConsentResponse
+	94,  // 177: healthanalytics.HealthAnalyticsService.RevokeDoctorConsent:output_type -> healthanalytics.RevokeDoctorConsentResponse
+	96,  // 178: healthanalytics.HealthAnalyticsService.ListDoctorConsents:output_type -> healthanalytics.ListDoctorConsentsResponse
+	99,  // 179: healthanalytics.HealthAnalyticsService.ListPatientsForDoctor:output_type -> healthanalytics.ListPatientsForDoctorResponse
+	101, // 180: healthanalytics.HealthAnalyticsService.ListMedicalRecordsByDoctor:output_type -> healthanalytics.ListMedicalRecordsByDoctorResponse
+	104, // 181: healthanalytics.HealthAnalyticsService.QueryAuditLog:output_type -> healthanalytics.QueryAuditLogResponse
+	106, // 182: healthanalytics.HealthAnalyticsService.VerifyAuditLog:output_type -> healthanalytics.VerifyAuditLogResponse
+	109, // 183: healthanalytics.HealthAnalyticsService.ListDeadLetters:output_type -> healthanalytics.ListDeadLettersResponse
+	111, // 184: healthanalytics.HealthAnalyticsService.ReplayDeadLetters:output_type -> healthanalytics.ReplayDeadLettersResponse
+	113, // 185: healthanalytics.HealthAnalyticsService.SetUserTimezone:output_type -> healthanalytics.SetUserTimezoneResponse
+	115, // 186: healthanalytics.HealthAnalyticsService.GetUserTimezone:output_type -> healthanalytics.GetUserTimezoneResponse
+	138, // [138:187] is the sub-list for method output_type
+	89,  // [89:138] is the sub-list for method input_type
+	89,  // [89:89] is the sub-list for extension type_name
+	89,  // [89:89] is the sub-list for extension extendee
+	0,   // [0:89] is the sub-list for field type_name
+}
+
+func init() { file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init() }
+func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init() {
+	if File_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateHealthRecommendationsIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateHealthRecommendationsIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllWearableDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllWearableDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Measurement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*MedicalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*AddMedicalRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*AddMedicalRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMedicalRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetMedicalRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateMedicalRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateMedicalRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteMedicalRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteMedicalRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMedicalRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMedicalRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreMedicalRecordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreMedicalRecordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedMedicalRecordsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedMedicalRecordsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachmentUploadInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*UploadAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*DownloadAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*LifestyleData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*AddLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*AddLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedLifestyleDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedLifestyleDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*WearableData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*AddWearableDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*AddWearableDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*AddWearableDataBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*AddWearableDataBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWearableDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWearableDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWearableDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateWearableDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteWearableDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteWearableDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreWearableDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*RestoreWearableDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3268,8 +9847,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateHealthRecommendationsIdRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedWearableDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3280,8 +9859,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllWearableDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeletedWearableDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3292,8 +9871,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllWearableDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*HealthRecommendation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3304,8 +9883,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllLifestyleDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateHealthRecommendationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3316,8 +9895,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllLifestyleDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*GenerateHealthRecommendationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3328,8 +9907,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*MedicalRecord); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRealtimeHealthMonitoringRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3340,8 +9919,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*AddMedicalRecordRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRealtimeHealthMonitoringResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3352,8 +9931,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*AddMedicalRecordResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*StreamRealtimeHealthMonitoringRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3364,8 +9943,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMedicalRecordRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*RealtimeHealthEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3376,8 +9955,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*GetMedicalRecordResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDailyHealthSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3388,8 +9967,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateMedicalRecordRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3400,8 +9979,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateMedicalRecordResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDailyHealthSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3412,8 +9991,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteMedicalRecordRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWeeklyHealthSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3424,8 +10003,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteMedicalRecordResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*DailyMetrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3436,8 +10015,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*ListMedicalRecordsRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*MetricTrend); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3448,8 +10027,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ListMedicalRecordsResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWeeklyHealthSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3460,8 +10039,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*LifestyleData); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*UserIDHealthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3472,8 +10051,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*AddLifestyleDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*UserIDHealthResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3484,8 +10063,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*AddLifestyleDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*AlertThreshold); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3496,8 +10075,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLifestyleDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAlertThresholdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3508,8 +10087,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*GetLifestyleDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAlertThresholdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3520,8 +10099,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateLifestyleDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAlertThresholdsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3532,8 +10111,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateLifestyleDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAlertThresholdsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3544,8 +10123,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteLifestyleDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAlertThresholdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3556,8 +10135,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteLifestyleDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAlertThresholdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3568,8 +10147,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*WearableData); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAlertThresholdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3580,8 +10159,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*AddWearableDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteAlertThresholdResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3592,8 +10171,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*AddWearableDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*Alert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3604,8 +10183,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*GetWearableDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAlertsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3616,8 +10195,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*GetWearableDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAlertsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3628,8 +10207,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateWearableDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*AcknowledgeAlertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3640,8 +10219,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateWearableDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*AcknowledgeAlertResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3652,8 +10231,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteWearableDataRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*DoctorConsent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3664,8 +10243,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteWearableDataResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*GrantDoctorConsentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3676,8 +10255,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*HealthRecommendation); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*GrantDoctorConsentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3688,8 +10267,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateHealthRecommendationsRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeDoctorConsentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3700,8 +10279,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*GenerateHealthRecommendationsResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeDoctorConsentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3712,8 +10291,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRealtimeHealthMonitoringRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDoctorConsentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3724,8 +10303,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*GetRealtimeHealthMonitoringResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDoctorConsentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3736,8 +10315,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDailyHealthSummaryRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*DoctorPatient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3748,8 +10327,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDailyHealthSummaryResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPatientsForDoctorRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3760,8 +10339,8 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*GetWeeklyHealthSummaryRequest); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPatientsForDoctorResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3772,8 +10351,188 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 				return nil
 			}
 		}
-		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*GetWeeklyHealthSummaryResponse); i {
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMedicalRecordsByDoctorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*ListMedicalRecordsByDoctorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditLogEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryAuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*QueryAuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyAuditLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyAuditLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplayDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*ReplayDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*SetUserTimezoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*SetUserTimezoneResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserTimezoneRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUserTimezoneResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3785,18 +10544,35 @@ func file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_init
 			}
 		}
 	}
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[23].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Info)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[27].OneofWrappers = []any{
+		(*DownloadAttachmentResponse_Attachment)(nil),
+		(*DownloadAttachmentResponse_Chunk)(nil),
+	}
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[64].OneofWrappers = []any{
+		(*RealtimeHealthEvent_WearableData)(nil),
+		(*RealtimeHealthEvent_Recommendation)(nil),
+		(*RealtimeHealthEvent_Alert)(nil),
+	}
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[74].OneofWrappers = []any{}
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[75].OneofWrappers = []any{}
+	file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes[79].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   44,
+			NumEnums:      2,
+			NumMessages:   116,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_goTypes,
 		DependencyIndexes: file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_depIdxs,
+		EnumInfos:         file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_enumTypes,
 		MessageInfos:      file_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto_msgTypes,
 	}.Build()
 	File_Medicine_and_Health_protos_HealthAnalytics_Health_Analytics_proto = out.File
@@ -24,22 +24,50 @@ const (
 	HealthAnalyticsService_UpdateMedicalRecord_FullMethodName             = "/healthanalytics.HealthAnalyticsService/UpdateMedicalRecord"
 	HealthAnalyticsService_DeleteMedicalRecord_FullMethodName             = "/healthanalytics.HealthAnalyticsService/DeleteMedicalRecord"
 	HealthAnalyticsService_ListMedicalRecords_FullMethodName              = "/healthanalytics.HealthAnalyticsService/ListMedicalRecords"
+	HealthAnalyticsService_RestoreMedicalRecord_FullMethodName            = "/healthanalytics.HealthAnalyticsService/RestoreMedicalRecord"
+	HealthAnalyticsService_ListDeletedMedicalRecords_FullMethodName       = "/healthanalytics.HealthAnalyticsService/ListDeletedMedicalRecords"
+	HealthAnalyticsService_UploadAttachment_FullMethodName                = "/healthanalytics.HealthAnalyticsService/UploadAttachment"
+	HealthAnalyticsService_DownloadAttachment_FullMethodName              = "/healthanalytics.HealthAnalyticsService/DownloadAttachment"
+	HealthAnalyticsService_DeleteAttachment_FullMethodName                = "/healthanalytics.HealthAnalyticsService/DeleteAttachment"
 	HealthAnalyticsService_AddLifestyleData_FullMethodName                = "/healthanalytics.HealthAnalyticsService/AddLifestyleData"
 	HealthAnalyticsService_GetLifestyleData_FullMethodName                = "/healthanalytics.HealthAnalyticsService/GetLifestyleData"
 	HealthAnalyticsService_GetAllLifestyleData_FullMethodName             = "/healthanalytics.HealthAnalyticsService/GetAllLifestyleData"
 	HealthAnalyticsService_UpdateLifestyleData_FullMethodName             = "/healthanalytics.HealthAnalyticsService/UpdateLifestyleData"
 	HealthAnalyticsService_DeleteLifestyleData_FullMethodName             = "/healthanalytics.HealthAnalyticsService/DeleteLifestyleData"
+	HealthAnalyticsService_RestoreLifestyleData_FullMethodName            = "/healthanalytics.HealthAnalyticsService/RestoreLifestyleData"
+	HealthAnalyticsService_ListDeletedLifestyleData_FullMethodName        = "/healthanalytics.HealthAnalyticsService/ListDeletedLifestyleData"
 	HealthAnalyticsService_AddWearableData_FullMethodName                 = "/healthanalytics.HealthAnalyticsService/AddWearableData"
+	HealthAnalyticsService_AddWearableDataBatch_FullMethodName            = "/healthanalytics.HealthAnalyticsService/AddWearableDataBatch"
 	HealthAnalyticsService_GetWearableData_FullMethodName                 = "/healthanalytics.HealthAnalyticsService/GetWearableData"
 	HealthAnalyticsService_GetAllWearableData_FullMethodName              = "/healthanalytics.HealthAnalyticsService/GetAllWearableData"
 	HealthAnalyticsService_UpdateWearableData_FullMethodName              = "/healthanalytics.HealthAnalyticsService/UpdateWearableData"
 	HealthAnalyticsService_DeleteWearableData_FullMethodName              = "/healthanalytics.HealthAnalyticsService/DeleteWearableData"
+	HealthAnalyticsService_RestoreWearableData_FullMethodName             = "/healthanalytics.HealthAnalyticsService/RestoreWearableData"
+	HealthAnalyticsService_ListDeletedWearableData_FullMethodName         = "/healthanalytics.HealthAnalyticsService/ListDeletedWearableData"
 	HealthAnalyticsService_GenerateHealthRecommendations_FullMethodName   = "/healthanalytics.HealthAnalyticsService/GenerateHealthRecommendations"
 	HealthAnalyticsService_GenerateHealthRecommendationsId_FullMethodName = "/healthanalytics.HealthAnalyticsService/GenerateHealthRecommendationsId"
 	HealthAnalyticsService_GetRealtimeHealthMonitoring_FullMethodName     = "/healthanalytics.HealthAnalyticsService/GetRealtimeHealthMonitoring"
+	HealthAnalyticsService_StreamRealtimeHealthMonitoring_FullMethodName  = "/healthanalytics.HealthAnalyticsService/StreamRealtimeHealthMonitoring"
 	HealthAnalyticsService_GetDailyHealthSummary_FullMethodName           = "/healthanalytics.HealthAnalyticsService/GetDailyHealthSummary"
 	HealthAnalyticsService_GetWeeklyHealthSummary_FullMethodName          = "/healthanalytics.HealthAnalyticsService/GetWeeklyHealthSummary"
 	HealthAnalyticsService_UserIDHealth_FullMethodName                    = "/healthanalytics.HealthAnalyticsService/UserIDHealth"
+	HealthAnalyticsService_CreateAlertThreshold_FullMethodName            = "/healthanalytics.HealthAnalyticsService/CreateAlertThreshold"
+	HealthAnalyticsService_ListAlertThresholds_FullMethodName             = "/healthanalytics.HealthAnalyticsService/ListAlertThresholds"
+	HealthAnalyticsService_UpdateAlertThreshold_FullMethodName            = "/healthanalytics.HealthAnalyticsService/UpdateAlertThreshold"
+	HealthAnalyticsService_DeleteAlertThreshold_FullMethodName            = "/healthanalytics.HealthAnalyticsService/DeleteAlertThreshold"
+	HealthAnalyticsService_ListAlerts_FullMethodName                      = "/healthanalytics.HealthAnalyticsService/ListAlerts"
+	HealthAnalyticsService_AcknowledgeAlert_FullMethodName                = "/healthanalytics.HealthAnalyticsService/AcknowledgeAlert"
+	HealthAnalyticsService_GrantDoctorConsent_FullMethodName              = "/healthanalytics.HealthAnalyticsService/GrantDoctorConsent"
+	HealthAnalyticsService_RevokeDoctorConsent_FullMethodName             = "/healthanalytics.HealthAnalyticsService/RevokeDoctorConsent"
+	HealthAnalyticsService_ListDoctorConsents_FullMethodName              = "/healthanalytics.HealthAnalyticsService/ListDoctorConsents"
+	HealthAnalyticsService_ListPatientsForDoctor_FullMethodName           = "/healthanalytics.HealthAnalyticsService/ListPatientsForDoctor"
+	HealthAnalyticsService_ListMedicalRecordsByDoctor_FullMethodName      = "/healthanalytics.HealthAnalyticsService/ListMedicalRecordsByDoctor"
+	HealthAnalyticsService_QueryAuditLog_FullMethodName                   = "/healthanalytics.HealthAnalyticsService/QueryAuditLog"
+	HealthAnalyticsService_VerifyAuditLog_FullMethodName                  = "/healthanalytics.HealthAnalyticsService/VerifyAuditLog"
+	HealthAnalyticsService_ListDeadLetters_FullMethodName                 = "/healthanalytics.HealthAnalyticsService/ListDeadLetters"
+	HealthAnalyticsService_ReplayDeadLetters_FullMethodName               = "/healthanalytics.HealthAnalyticsService/ReplayDeadLetters"
+	HealthAnalyticsService_SetUserTimezone_FullMethodName                 = "/healthanalytics.HealthAnalyticsService/SetUserTimezone"
+	HealthAnalyticsService_GetUserTimezone_FullMethodName                 = "/healthanalytics.HealthAnalyticsService/GetUserTimezone"
 )
 
 // HealthAnalyticsServiceClient is the client API for HealthAnalyticsService service.
@@ -54,25 +82,59 @@ type HealthAnalyticsServiceClient interface {
 	UpdateMedicalRecord(ctx context.Context, in *UpdateMedicalRecordRequest, opts ...grpc.CallOption) (*UpdateMedicalRecordResponse, error)
 	DeleteMedicalRecord(ctx context.Context, in *DeleteMedicalRecordRequest, opts ...grpc.CallOption) (*DeleteMedicalRecordResponse, error)
 	ListMedicalRecords(ctx context.Context, in *ListMedicalRecordsRequest, opts ...grpc.CallOption) (*ListMedicalRecordsResponse, error)
+	RestoreMedicalRecord(ctx context.Context, in *RestoreMedicalRecordRequest, opts ...grpc.CallOption) (*RestoreMedicalRecordResponse, error)
+	ListDeletedMedicalRecords(ctx context.Context, in *ListDeletedMedicalRecordsRequest, opts ...grpc.CallOption) (*ListDeletedMedicalRecordsResponse, error)
+	// Tibbiy yozuv fayllari uchun RPC lar
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (HealthAnalyticsService_UploadAttachmentClient, error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (HealthAnalyticsService_DownloadAttachmentClient, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
 	// Turmush tarzi ma'lumotlari uchun RPC lar
 	AddLifestyleData(ctx context.Context, in *AddLifestyleDataRequest, opts ...grpc.CallOption) (*AddLifestyleDataResponse, error)
 	GetLifestyleData(ctx context.Context, in *GetLifestyleDataRequest, opts ...grpc.CallOption) (*GetLifestyleDataResponse, error)
 	GetAllLifestyleData(ctx context.Context, in *GetAllLifestyleDataRequest, opts ...grpc.CallOption) (*GetAllLifestyleDataResponse, error)
 	UpdateLifestyleData(ctx context.Context, in *UpdateLifestyleDataRequest, opts ...grpc.CallOption) (*UpdateLifestyleDataResponse, error)
 	DeleteLifestyleData(ctx context.Context, in *DeleteLifestyleDataRequest, opts ...grpc.CallOption) (*DeleteLifestyleDataResponse, error)
+	RestoreLifestyleData(ctx context.Context, in *RestoreLifestyleDataRequest, opts ...grpc.CallOption) (*RestoreLifestyleDataResponse, error)
+	ListDeletedLifestyleData(ctx context.Context, in *ListDeletedLifestyleDataRequest, opts ...grpc.CallOption) (*ListDeletedLifestyleDataResponse, error)
 	// Kiyiladigan qurilma ma'lumotlari uchun RPC lar
 	AddWearableData(ctx context.Context, in *AddWearableDataRequest, opts ...grpc.CallOption) (*AddWearableDataResponse, error)
+	AddWearableDataBatch(ctx context.Context, in *AddWearableDataBatchRequest, opts ...grpc.CallOption) (*AddWearableDataBatchResponse, error)
 	GetWearableData(ctx context.Context, in *GetWearableDataRequest, opts ...grpc.CallOption) (*GetWearableDataResponse, error)
 	GetAllWearableData(ctx context.Context, in *GetAllWearableDataRequest, opts ...grpc.CallOption) (*GetAllWearableDataResponse, error)
 	UpdateWearableData(ctx context.Context, in *UpdateWearableDataRequest, opts ...grpc.CallOption) (*UpdateWearableDataResponse, error)
 	DeleteWearableData(ctx context.Context, in *DeleteWearableDataRequest, opts ...grpc.CallOption) (*DeleteWearableDataResponse, error)
+	RestoreWearableData(ctx context.Context, in *RestoreWearableDataRequest, opts ...grpc.CallOption) (*RestoreWearableDataResponse, error)
+	ListDeletedWearableData(ctx context.Context, in *ListDeletedWearableDataRequest, opts ...grpc.CallOption) (*ListDeletedWearableDataResponse, error)
 	// Sog'liq tavsiyalari va monitoringi uchun RPC lar
 	GenerateHealthRecommendations(ctx context.Context, in *GenerateHealthRecommendationsRequest, opts ...grpc.CallOption) (*GenerateHealthRecommendationsResponse, error)
 	GenerateHealthRecommendationsId(ctx context.Context, in *GenerateHealthRecommendationsIdRequest, opts ...grpc.CallOption) (*GenerateHealthRecommendationsIdResponse, error)
 	GetRealtimeHealthMonitoring(ctx context.Context, in *GetRealtimeHealthMonitoringRequest, opts ...grpc.CallOption) (*GetRealtimeHealthMonitoringResponse, error)
+	StreamRealtimeHealthMonitoring(ctx context.Context, in *StreamRealtimeHealthMonitoringRequest, opts ...grpc.CallOption) (HealthAnalyticsService_StreamRealtimeHealthMonitoringClient, error)
 	GetDailyHealthSummary(ctx context.Context, in *GetDailyHealthSummaryRequest, opts ...grpc.CallOption) (*GetDailyHealthSummaryResponse, error)
 	GetWeeklyHealthSummary(ctx context.Context, in *GetWeeklyHealthSummaryRequest, opts ...grpc.CallOption) (*GetWeeklyHealthSummaryResponse, error)
-	UserIDHealth(ctx context.Context, in *GetRealtimeHealthMonitoringRequest, opts ...grpc.CallOption) (*GetRealtimeHealthMonitoringResponse, error)
+	UserIDHealth(ctx context.Context, in *UserIDHealthRequest, opts ...grpc.CallOption) (*UserIDHealthResponse, error)
+	// Ogohlantirishlar uchun RPC lar
+	CreateAlertThreshold(ctx context.Context, in *CreateAlertThresholdRequest, opts ...grpc.CallOption) (*CreateAlertThresholdResponse, error)
+	ListAlertThresholds(ctx context.Context, in *ListAlertThresholdsRequest, opts ...grpc.CallOption) (*ListAlertThresholdsResponse, error)
+	UpdateAlertThreshold(ctx context.Context, in *UpdateAlertThresholdRequest, opts ...grpc.CallOption) (*UpdateAlertThresholdResponse, error)
+	DeleteAlertThreshold(ctx context.Context, in *DeleteAlertThresholdRequest, opts ...grpc.CallOption) (*DeleteAlertThresholdResponse, error)
+	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error)
+	// Shifokorlar uchun RPC lar
+	GrantDoctorConsent(ctx context.Context, in *GrantDoctorConsentRequest, opts ...grpc.CallOption) (*GrantDoctorConsentResponse, error)
+	RevokeDoctorConsent(ctx context.Context, in *RevokeDoctorConsentRequest, opts ...grpc.CallOption) (*RevokeDoctorConsentResponse, error)
+	ListDoctorConsents(ctx context.Context, in *ListDoctorConsentsRequest, opts ...grpc.CallOption) (*ListDoctorConsentsResponse, error)
+	ListPatientsForDoctor(ctx context.Context, in *ListPatientsForDoctorRequest, opts ...grpc.CallOption) (*ListPatientsForDoctorResponse, error)
+	ListMedicalRecordsByDoctor(ctx context.Context, in *ListMedicalRecordsByDoctorRequest, opts ...grpc.CallOption) (*ListMedicalRecordsByDoctorResponse, error)
+	// Audit log uchun RPC lar
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	// Dead-letter navbatlari uchun RPC lar
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
+	// Foydalanuvchi sozlamalari uchun RPC lar
+	SetUserTimezone(ctx context.Context, in *SetUserTimezoneRequest, opts ...grpc.CallOption) (*SetUserTimezoneResponse, error)
+	GetUserTimezone(ctx context.Context, in *GetUserTimezoneRequest, opts ...grpc.CallOption) (*GetUserTimezoneResponse, error)
 }
 
 type healthAnalyticsServiceClient struct {
@@ -133,6 +195,104 @@ func (c *healthAnalyticsServiceClient) ListMedicalRecords(ctx context.Context, i
 	return out, nil
 }
 
+func (c *healthAnalyticsServiceClient) RestoreMedicalRecord(ctx context.Context, in *RestoreMedicalRecordRequest, opts ...grpc.CallOption) (*RestoreMedicalRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreMedicalRecordResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_RestoreMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListDeletedMedicalRecords(ctx context.Context, in *ListDeletedMedicalRecordsRequest, opts ...grpc.CallOption) (*ListDeletedMedicalRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedMedicalRecordsResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListDeletedMedicalRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (HealthAnalyticsService_UploadAttachmentClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HealthAnalyticsService_ServiceDesc.Streams[0], HealthAnalyticsService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &healthAnalyticsServiceUploadAttachmentClient{ClientStream: stream}
+	return x, nil
+}
+
+type HealthAnalyticsService_UploadAttachmentClient interface {
+	Send(*UploadAttachmentRequest) error
+	CloseAndRecv() (*UploadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type healthAnalyticsServiceUploadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthAnalyticsServiceUploadAttachmentClient) Send(m *UploadAttachmentRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *healthAnalyticsServiceUploadAttachmentClient) CloseAndRecv() (*UploadAttachmentResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *healthAnalyticsServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (HealthAnalyticsService_DownloadAttachmentClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HealthAnalyticsService_ServiceDesc.Streams[1], HealthAnalyticsService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &healthAnalyticsServiceDownloadAttachmentClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HealthAnalyticsService_DownloadAttachmentClient interface {
+	Recv() (*DownloadAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type healthAnalyticsServiceDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthAnalyticsServiceDownloadAttachmentClient) Recv() (*DownloadAttachmentResponse, error) {
+	m := new(DownloadAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *healthAnalyticsServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *healthAnalyticsServiceClient) AddLifestyleData(ctx context.Context, in *AddLifestyleDataRequest, opts ...grpc.CallOption) (*AddLifestyleDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddLifestyleDataResponse)
@@ -183,6 +343,26 @@ func (c *healthAnalyticsServiceClient) DeleteLifestyleData(ctx context.Context,
 	return out, nil
 }
 
+func (c *healthAnalyticsServiceClient) RestoreLifestyleData(ctx context.Context, in *RestoreLifestyleDataRequest, opts ...grpc.CallOption) (*RestoreLifestyleDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreLifestyleDataResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_RestoreLifestyleData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListDeletedLifestyleData(ctx context.Context, in *ListDeletedLifestyleDataRequest, opts ...grpc.CallOption) (*ListDeletedLifestyleDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedLifestyleDataResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListDeletedLifestyleData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *healthAnalyticsServiceClient) AddWearableData(ctx context.Context, in *AddWearableDataRequest, opts ...grpc.CallOption) (*AddWearableDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddWearableDataResponse)
@@ -193,6 +373,16 @@ func (c *healthAnalyticsServiceClient) AddWearableData(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *healthAnalyticsServiceClient) AddWearableDataBatch(ctx context.Context, in *AddWearableDataBatchRequest, opts ...grpc.CallOption) (*AddWearableDataBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWearableDataBatchResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_AddWearableDataBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *healthAnalyticsServiceClient) GetWearableData(ctx context.Context, in *GetWearableDataRequest, opts ...grpc.CallOption) (*GetWearableDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWearableDataResponse)
@@ -233,6 +423,26 @@ func (c *healthAnalyticsServiceClient) DeleteWearableData(ctx context.Context, i
 	return out, nil
 }
 
+func (c *healthAnalyticsServiceClient) RestoreWearableData(ctx context.Context, in *RestoreWearableDataRequest, opts ...grpc.CallOption) (*RestoreWearableDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreWearableDataResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_RestoreWearableData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListDeletedWearableData(ctx context.Context, in *ListDeletedWearableDataRequest, opts ...grpc.CallOption) (*ListDeletedWearableDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedWearableDataResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListDeletedWearableData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *healthAnalyticsServiceClient) GenerateHealthRecommendations(ctx context.Context, in *GenerateHealthRecommendationsRequest, opts ...grpc.CallOption) (*GenerateHealthRecommendationsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenerateHealthRecommendationsResponse)
@@ -263,6 +473,39 @@ func (c *healthAnalyticsServiceClient) GetRealtimeHealthMonitoring(ctx context.C
 	return out, nil
 }
 
+func (c *healthAnalyticsServiceClient) StreamRealtimeHealthMonitoring(ctx context.Context, in *StreamRealtimeHealthMonitoringRequest, opts ...grpc.CallOption) (HealthAnalyticsService_StreamRealtimeHealthMonitoringClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &HealthAnalyticsService_ServiceDesc.Streams[2], HealthAnalyticsService_StreamRealtimeHealthMonitoring_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &healthAnalyticsServiceStreamRealtimeHealthMonitoringClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type HealthAnalyticsService_StreamRealtimeHealthMonitoringClient interface {
+	Recv() (*RealtimeHealthEvent, error)
+	grpc.ClientStream
+}
+
+type healthAnalyticsServiceStreamRealtimeHealthMonitoringClient struct {
+	grpc.ClientStream
+}
+
+func (x *healthAnalyticsServiceStreamRealtimeHealthMonitoringClient) Recv() (*RealtimeHealthEvent, error) {
+	m := new(RealtimeHealthEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *healthAnalyticsServiceClient) GetDailyHealthSummary(ctx context.Context, in *GetDailyHealthSummaryRequest, opts ...grpc.CallOption) (*GetDailyHealthSummaryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDailyHealthSummaryResponse)
@@ -283,9 +526,9 @@ func (c *healthAnalyticsServiceClient) GetWeeklyHealthSummary(ctx context.Contex
 	return out, nil
 }
 
-func (c *healthAnalyticsServiceClient) UserIDHealth(ctx context.Context, in *GetRealtimeHealthMonitoringRequest, opts ...grpc.CallOption) (*GetRealtimeHealthMonitoringResponse, error) {
+func (c *healthAnalyticsServiceClient) UserIDHealth(ctx context.Context, in *UserIDHealthRequest, opts ...grpc.CallOption) (*UserIDHealthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetRealtimeHealthMonitoringResponse)
+	out := new(UserIDHealthResponse)
 	err := c.cc.Invoke(ctx, HealthAnalyticsService_UserIDHealth_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -293,6 +536,176 @@ func (c *healthAnalyticsServiceClient) UserIDHealth(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *healthAnalyticsServiceClient) CreateAlertThreshold(ctx context.Context, in *CreateAlertThresholdRequest, opts ...grpc.CallOption) (*CreateAlertThresholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAlertThresholdResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_CreateAlertThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListAlertThresholds(ctx context.Context, in *ListAlertThresholdsRequest, opts ...grpc.CallOption) (*ListAlertThresholdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertThresholdsResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListAlertThresholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) UpdateAlertThreshold(ctx context.Context, in *UpdateAlertThresholdRequest, opts ...grpc.CallOption) (*UpdateAlertThresholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAlertThresholdResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_UpdateAlertThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) DeleteAlertThreshold(ctx context.Context, in *DeleteAlertThresholdRequest, opts ...grpc.CallOption) (*DeleteAlertThresholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertThresholdResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_DeleteAlertThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertsResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeAlertResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_AcknowledgeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) GrantDoctorConsent(ctx context.Context, in *GrantDoctorConsentRequest, opts ...grpc.CallOption) (*GrantDoctorConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GrantDoctorConsentResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_GrantDoctorConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) RevokeDoctorConsent(ctx context.Context, in *RevokeDoctorConsentRequest, opts ...grpc.CallOption) (*RevokeDoctorConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDoctorConsentResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_RevokeDoctorConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListDoctorConsents(ctx context.Context, in *ListDoctorConsentsRequest, opts ...grpc.CallOption) (*ListDoctorConsentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDoctorConsentsResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListDoctorConsents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListPatientsForDoctor(ctx context.Context, in *ListPatientsForDoctorRequest, opts ...grpc.CallOption) (*ListPatientsForDoctorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPatientsForDoctorResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListPatientsForDoctor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListMedicalRecordsByDoctor(ctx context.Context, in *ListMedicalRecordsByDoctorRequest, opts ...grpc.CallOption) (*ListMedicalRecordsByDoctorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMedicalRecordsByDoctorResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListMedicalRecordsByDoctor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_QueryAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ListDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_ReplayDeadLetters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) SetUserTimezone(ctx context.Context, in *SetUserTimezoneRequest, opts ...grpc.CallOption) (*SetUserTimezoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserTimezoneResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_SetUserTimezone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthAnalyticsServiceClient) GetUserTimezone(ctx context.Context, in *GetUserTimezoneRequest, opts ...grpc.CallOption) (*GetUserTimezoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserTimezoneResponse)
+	err := c.cc.Invoke(ctx, HealthAnalyticsService_GetUserTimezone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthAnalyticsServiceServer is the server API for HealthAnalyticsService service.
 // All implementations must embed UnimplementedHealthAnalyticsServiceServer
 // for forward compatibility
@@ -305,25 +718,59 @@ type HealthAnalyticsServiceServer interface {
 	UpdateMedicalRecord(context.Context, *UpdateMedicalRecordRequest) (*UpdateMedicalRecordResponse, error)
 	DeleteMedicalRecord(context.Context, *DeleteMedicalRecordRequest) (*DeleteMedicalRecordResponse, error)
 	ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error)
+	RestoreMedicalRecord(context.Context, *RestoreMedicalRecordRequest) (*RestoreMedicalRecordResponse, error)
+	ListDeletedMedicalRecords(context.Context, *ListDeletedMedicalRecordsRequest) (*ListDeletedMedicalRecordsResponse, error)
+	// Tibbiy yozuv fayllari uchun RPC lar
+	UploadAttachment(HealthAnalyticsService_UploadAttachmentServer) error
+	DownloadAttachment(*DownloadAttachmentRequest, HealthAnalyticsService_DownloadAttachmentServer) error
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
 	// Turmush tarzi ma'lumotlari uchun RPC lar
 	AddLifestyleData(context.Context, *AddLifestyleDataRequest) (*AddLifestyleDataResponse, error)
 	GetLifestyleData(context.Context, *GetLifestyleDataRequest) (*GetLifestyleDataResponse, error)
 	GetAllLifestyleData(context.Context, *GetAllLifestyleDataRequest) (*GetAllLifestyleDataResponse, error)
 	UpdateLifestyleData(context.Context, *UpdateLifestyleDataRequest) (*UpdateLifestyleDataResponse, error)
 	DeleteLifestyleData(context.Context, *DeleteLifestyleDataRequest) (*DeleteLifestyleDataResponse, error)
+	RestoreLifestyleData(context.Context, *RestoreLifestyleDataRequest) (*RestoreLifestyleDataResponse, error)
+	ListDeletedLifestyleData(context.Context, *ListDeletedLifestyleDataRequest) (*ListDeletedLifestyleDataResponse, error)
 	// Kiyiladigan qurilma ma'lumotlari uchun RPC lar
 	AddWearableData(context.Context, *AddWearableDataRequest) (*AddWearableDataResponse, error)
+	AddWearableDataBatch(context.Context, *AddWearableDataBatchRequest) (*AddWearableDataBatchResponse, error)
 	GetWearableData(context.Context, *GetWearableDataRequest) (*GetWearableDataResponse, error)
 	GetAllWearableData(context.Context, *GetAllWearableDataRequest) (*GetAllWearableDataResponse, error)
 	UpdateWearableData(context.Context, *UpdateWearableDataRequest) (*UpdateWearableDataResponse, error)
 	DeleteWearableData(context.Context, *DeleteWearableDataRequest) (*DeleteWearableDataResponse, error)
+	RestoreWearableData(context.Context, *RestoreWearableDataRequest) (*RestoreWearableDataResponse, error)
+	ListDeletedWearableData(context.Context, *ListDeletedWearableDataRequest) (*ListDeletedWearableDataResponse, error)
 	// Sog'liq tavsiyalari va monitoringi uchun RPC lar
 	GenerateHealthRecommendations(context.Context, *GenerateHealthRecommendationsRequest) (*GenerateHealthRecommendationsResponse, error)
 	GenerateHealthRecommendationsId(context.Context, *GenerateHealthRecommendationsIdRequest) (*GenerateHealthRecommendationsIdResponse, error)
 	GetRealtimeHealthMonitoring(context.Context, *GetRealtimeHealthMonitoringRequest) (*GetRealtimeHealthMonitoringResponse, error)
+	StreamRealtimeHealthMonitoring(*StreamRealtimeHealthMonitoringRequest, HealthAnalyticsService_StreamRealtimeHealthMonitoringServer) error
 	GetDailyHealthSummary(context.Context, *GetDailyHealthSummaryRequest) (*GetDailyHealthSummaryResponse, error)
 	GetWeeklyHealthSummary(context.Context, *GetWeeklyHealthSummaryRequest) (*GetWeeklyHealthSummaryResponse, error)
-	UserIDHealth(context.Context, *GetRealtimeHealthMonitoringRequest) (*GetRealtimeHealthMonitoringResponse, error)
+	UserIDHealth(context.Context, *UserIDHealthRequest) (*UserIDHealthResponse, error)
+	// Ogohlantirishlar uchun RPC lar
+	CreateAlertThreshold(context.Context, *CreateAlertThresholdRequest) (*CreateAlertThresholdResponse, error)
+	ListAlertThresholds(context.Context, *ListAlertThresholdsRequest) (*ListAlertThresholdsResponse, error)
+	UpdateAlertThreshold(context.Context, *UpdateAlertThresholdRequest) (*UpdateAlertThresholdResponse, error)
+	DeleteAlertThreshold(context.Context, *DeleteAlertThresholdRequest) (*DeleteAlertThresholdResponse, error)
+	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error)
+	// Shifokorlar uchun RPC lar
+	GrantDoctorConsent(context.Context, *GrantDoctorConsentRequest) (*GrantDoctorConsentResponse, error)
+	RevokeDoctorConsent(context.Context, *RevokeDoctorConsentRequest) (*RevokeDoctorConsentResponse, error)
+	ListDoctorConsents(context.Context, *ListDoctorConsentsRequest) (*ListDoctorConsentsResponse, error)
+	ListPatientsForDoctor(context.Context, *ListPatientsForDoctorRequest) (*ListPatientsForDoctorResponse, error)
+	ListMedicalRecordsByDoctor(context.Context, *ListMedicalRecordsByDoctorRequest) (*ListMedicalRecordsByDoctorResponse, error)
+	// Audit log uchun RPC lar
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	// Dead-letter navbatlari uchun RPC lar
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
+	// Foydalanuvchi sozlamalari uchun RPC lar
+	SetUserTimezone(context.Context, *SetUserTimezoneRequest) (*SetUserTimezoneResponse, error)
+	GetUserTimezone(context.Context, *GetUserTimezoneRequest) (*GetUserTimezoneResponse, error)
 	mustEmbedUnimplementedHealthAnalyticsServiceServer()
 }
 
@@ -346,6 +793,21 @@ func (UnimplementedHealthAnalyticsServiceServer) DeleteMedicalRecord(context.Con
 func (UnimplementedHealthAnalyticsServiceServer) ListMedicalRecords(context.Context, *ListMedicalRecordsRequest) (*ListMedicalRecordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMedicalRecords not implemented")
 }
+func (UnimplementedHealthAnalyticsServiceServer) RestoreMedicalRecord(context.Context, *RestoreMedicalRecordRequest) (*RestoreMedicalRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMedicalRecord not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListDeletedMedicalRecords(context.Context, *ListDeletedMedicalRecordsRequest) (*ListDeletedMedicalRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedMedicalRecords not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) UploadAttachment(HealthAnalyticsService_UploadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) DownloadAttachment(*DownloadAttachmentRequest, HealthAnalyticsService_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
 func (UnimplementedHealthAnalyticsServiceServer) AddLifestyleData(context.Context, *AddLifestyleDataRequest) (*AddLifestyleDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddLifestyleData not implemented")
 }
@@ -361,9 +823,18 @@ func (UnimplementedHealthAnalyticsServiceServer) UpdateLifestyleData(context.Con
 func (UnimplementedHealthAnalyticsServiceServer) DeleteLifestyleData(context.Context, *DeleteLifestyleDataRequest) (*DeleteLifestyleDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLifestyleData not implemented")
 }
+func (UnimplementedHealthAnalyticsServiceServer) RestoreLifestyleData(context.Context, *RestoreLifestyleDataRequest) (*RestoreLifestyleDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLifestyleData not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListDeletedLifestyleData(context.Context, *ListDeletedLifestyleDataRequest) (*ListDeletedLifestyleDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedLifestyleData not implemented")
+}
 func (UnimplementedHealthAnalyticsServiceServer) AddWearableData(context.Context, *AddWearableDataRequest) (*AddWearableDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddWearableData not implemented")
 }
+func (UnimplementedHealthAnalyticsServiceServer) AddWearableDataBatch(context.Context, *AddWearableDataBatchRequest) (*AddWearableDataBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWearableDataBatch not implemented")
+}
 func (UnimplementedHealthAnalyticsServiceServer) GetWearableData(context.Context, *GetWearableDataRequest) (*GetWearableDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWearableData not implemented")
 }
@@ -376,6 +847,12 @@ func (UnimplementedHealthAnalyticsServiceServer) UpdateWearableData(context.Cont
 func (UnimplementedHealthAnalyticsServiceServer) DeleteWearableData(context.Context, *DeleteWearableDataRequest) (*DeleteWearableDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWearableData not implemented")
 }
+func (UnimplementedHealthAnalyticsServiceServer) RestoreWearableData(context.Context, *RestoreWearableDataRequest) (*RestoreWearableDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWearableData not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListDeletedWearableData(context.Context, *ListDeletedWearableDataRequest) (*ListDeletedWearableDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedWearableData not implemented")
+}
 func (UnimplementedHealthAnalyticsServiceServer) GenerateHealthRecommendations(context.Context, *GenerateHealthRecommendationsRequest) (*GenerateHealthRecommendationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateHealthRecommendations not implemented")
 }
@@ -385,403 +862,975 @@ func (UnimplementedHealthAnalyticsServiceServer) GenerateHealthRecommendationsId
 func (UnimplementedHealthAnalyticsServiceServer) GetRealtimeHealthMonitoring(context.Context, *GetRealtimeHealthMonitoringRequest) (*GetRealtimeHealthMonitoringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRealtimeHealthMonitoring not implemented")
 }
+func (UnimplementedHealthAnalyticsServiceServer) StreamRealtimeHealthMonitoring(*StreamRealtimeHealthMonitoringRequest, HealthAnalyticsService_StreamRealtimeHealthMonitoringServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRealtimeHealthMonitoring not implemented")
+}
 func (UnimplementedHealthAnalyticsServiceServer) GetDailyHealthSummary(context.Context, *GetDailyHealthSummaryRequest) (*GetDailyHealthSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDailyHealthSummary not implemented")
 }
 func (UnimplementedHealthAnalyticsServiceServer) GetWeeklyHealthSummary(context.Context, *GetWeeklyHealthSummaryRequest) (*GetWeeklyHealthSummaryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWeeklyHealthSummary not implemented")
 }
-func (UnimplementedHealthAnalyticsServiceServer) UserIDHealth(context.Context, *GetRealtimeHealthMonitoringRequest) (*GetRealtimeHealthMonitoringResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserIDHealth not implemented")
+func (UnimplementedHealthAnalyticsServiceServer) UserIDHealth(context.Context, *UserIDHealthRequest) (*UserIDHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserIDHealth not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) CreateAlertThreshold(context.Context, *CreateAlertThresholdRequest) (*CreateAlertThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertThreshold not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListAlertThresholds(context.Context, *ListAlertThresholdsRequest) (*ListAlertThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertThresholds not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) UpdateAlertThreshold(context.Context, *UpdateAlertThresholdRequest) (*UpdateAlertThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertThreshold not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) DeleteAlertThreshold(context.Context, *DeleteAlertThresholdRequest) (*DeleteAlertThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertThreshold not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) GrantDoctorConsent(context.Context, *GrantDoctorConsentRequest) (*GrantDoctorConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantDoctorConsent not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) RevokeDoctorConsent(context.Context, *RevokeDoctorConsentRequest) (*RevokeDoctorConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDoctorConsent not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListDoctorConsents(context.Context, *ListDoctorConsentsRequest) (*ListDoctorConsentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDoctorConsents not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListPatientsForDoctor(context.Context, *ListPatientsForDoctorRequest) (*ListPatientsForDoctorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPatientsForDoctor not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListMedicalRecordsByDoctor(context.Context, *ListMedicalRecordsByDoctorRequest) (*ListMedicalRecordsByDoctorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMedicalRecordsByDoctor not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) SetUserTimezone(context.Context, *SetUserTimezoneRequest) (*SetUserTimezoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserTimezone not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) GetUserTimezone(context.Context, *GetUserTimezoneRequest) (*GetUserTimezoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserTimezone not implemented")
+}
+func (UnimplementedHealthAnalyticsServiceServer) mustEmbedUnimplementedHealthAnalyticsServiceServer() {
+}
+
+// UnsafeHealthAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HealthAnalyticsServiceServer will
+// result in compilation errors.
+type UnsafeHealthAnalyticsServiceServer interface {
+	mustEmbedUnimplementedHealthAnalyticsServiceServer()
+}
+
+func RegisterHealthAnalyticsServiceServer(s grpc.ServiceRegistrar, srv HealthAnalyticsServiceServer) {
+	s.RegisterService(&HealthAnalyticsService_ServiceDesc, srv)
+}
+
+func _HealthAnalyticsService_AddMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).AddMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_AddMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).AddMedicalRecord(ctx, req.(*AddMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_GetMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).GetMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_GetMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).GetMedicalRecord(ctx, req.(*GetMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_UpdateMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).UpdateMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_UpdateMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).UpdateMedicalRecord(ctx, req.(*UpdateMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_DeleteMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).DeleteMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_DeleteMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).DeleteMedicalRecord(ctx, req.(*DeleteMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_ListMedicalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicalRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).ListMedicalRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_ListMedicalRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).ListMedicalRecords(ctx, req.(*ListMedicalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_RestoreMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).RestoreMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_RestoreMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).RestoreMedicalRecord(ctx, req.(*RestoreMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_ListDeletedMedicalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedMedicalRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).ListDeletedMedicalRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_ListDeletedMedicalRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).ListDeletedMedicalRecords(ctx, req.(*ListDeletedMedicalRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(HealthAnalyticsServiceServer).UploadAttachment(&healthAnalyticsServiceUploadAttachmentServer{ServerStream: stream})
+}
+
+type HealthAnalyticsService_UploadAttachmentServer interface {
+	SendAndClose(*UploadAttachmentResponse) error
+	Recv() (*UploadAttachmentRequest, error)
+	grpc.ServerStream
+}
+
+type healthAnalyticsServiceUploadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthAnalyticsServiceUploadAttachmentServer) SendAndClose(m *UploadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *healthAnalyticsServiceUploadAttachmentServer) Recv() (*UploadAttachmentRequest, error) {
+	m := new(UploadAttachmentRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _HealthAnalyticsService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HealthAnalyticsServiceServer).DownloadAttachment(m, &healthAnalyticsServiceDownloadAttachmentServer{ServerStream: stream})
+}
+
+type HealthAnalyticsService_DownloadAttachmentServer interface {
+	Send(*DownloadAttachmentResponse) error
+	grpc.ServerStream
+}
+
+type healthAnalyticsServiceDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthAnalyticsServiceDownloadAttachmentServer) Send(m *DownloadAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HealthAnalyticsService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_AddLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLifestyleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).AddLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_AddLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).AddLifestyleData(ctx, req.(*AddLifestyleDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_GetLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLifestyleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).GetLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_GetLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).GetLifestyleData(ctx, req.(*GetLifestyleDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_GetAllLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllLifestyleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).GetAllLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_GetAllLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).GetAllLifestyleData(ctx, req.(*GetAllLifestyleDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_UpdateLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLifestyleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).UpdateLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_UpdateLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).UpdateLifestyleData(ctx, req.(*UpdateLifestyleDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_DeleteLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLifestyleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).DeleteLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_DeleteLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).DeleteLifestyleData(ctx, req.(*DeleteLifestyleDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_RestoreLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLifestyleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).RestoreLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_RestoreLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).RestoreLifestyleData(ctx, req.(*RestoreLifestyleDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_ListDeletedLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedLifestyleDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).ListDeletedLifestyleData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_ListDeletedLifestyleData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).ListDeletedLifestyleData(ctx, req.(*ListDeletedLifestyleDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_AddWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWearableDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).AddWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_AddWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).AddWearableData(ctx, req.(*AddWearableDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_AddWearableDataBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWearableDataBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).AddWearableDataBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_AddWearableDataBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).AddWearableDataBatch(ctx, req.(*AddWearableDataBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_GetWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWearableDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).GetWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_GetWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).GetWearableData(ctx, req.(*GetWearableDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_GetAllWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllWearableDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).GetAllWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_GetAllWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).GetAllWearableData(ctx, req.(*GetAllWearableDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_UpdateWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWearableDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).UpdateWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_UpdateWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).UpdateWearableData(ctx, req.(*UpdateWearableDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_DeleteWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWearableDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).DeleteWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_DeleteWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).DeleteWearableData(ctx, req.(*DeleteWearableDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthAnalyticsService_RestoreWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWearableDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).RestoreWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_RestoreWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).RestoreWearableData(ctx, req.(*RestoreWearableDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedHealthAnalyticsServiceServer) mustEmbedUnimplementedHealthAnalyticsServiceServer() {
+
+func _HealthAnalyticsService_ListDeletedWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedWearableDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).ListDeletedWearableData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_ListDeletedWearableData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).ListDeletedWearableData(ctx, req.(*ListDeletedWearableDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeHealthAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to HealthAnalyticsServiceServer will
-// result in compilation errors.
-type UnsafeHealthAnalyticsServiceServer interface {
-	mustEmbedUnimplementedHealthAnalyticsServiceServer()
+func _HealthAnalyticsService_GenerateHealthRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateHealthRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_GenerateHealthRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendations(ctx, req.(*GenerateHealthRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterHealthAnalyticsServiceServer(s grpc.ServiceRegistrar, srv HealthAnalyticsServiceServer) {
-	s.RegisterService(&HealthAnalyticsService_ServiceDesc, srv)
+func _HealthAnalyticsService_GenerateHealthRecommendationsId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateHealthRecommendationsIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendationsId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HealthAnalyticsService_GenerateHealthRecommendationsId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendationsId(ctx, req.(*GenerateHealthRecommendationsIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_AddMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMedicalRecordRequest)
+func _HealthAnalyticsService_GetRealtimeHealthMonitoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRealtimeHealthMonitoringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).AddMedicalRecord(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).GetRealtimeHealthMonitoring(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_AddMedicalRecord_FullMethodName,
+		FullMethod: HealthAnalyticsService_GetRealtimeHealthMonitoring_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).AddMedicalRecord(ctx, req.(*AddMedicalRecordRequest))
+		return srv.(HealthAnalyticsServiceServer).GetRealtimeHealthMonitoring(ctx, req.(*GetRealtimeHealthMonitoringRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMedicalRecordRequest)
+func _HealthAnalyticsService_StreamRealtimeHealthMonitoring_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRealtimeHealthMonitoringRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HealthAnalyticsServiceServer).StreamRealtimeHealthMonitoring(m, &healthAnalyticsServiceStreamRealtimeHealthMonitoringServer{ServerStream: stream})
+}
+
+type HealthAnalyticsService_StreamRealtimeHealthMonitoringServer interface {
+	Send(*RealtimeHealthEvent) error
+	grpc.ServerStream
+}
+
+type healthAnalyticsServiceStreamRealtimeHealthMonitoringServer struct {
+	grpc.ServerStream
+}
+
+func (x *healthAnalyticsServiceStreamRealtimeHealthMonitoringServer) Send(m *RealtimeHealthEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _HealthAnalyticsService_GetDailyHealthSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyHealthSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetMedicalRecord(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).GetDailyHealthSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetMedicalRecord_FullMethodName,
+		FullMethod: HealthAnalyticsService_GetDailyHealthSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetMedicalRecord(ctx, req.(*GetMedicalRecordRequest))
+		return srv.(HealthAnalyticsServiceServer).GetDailyHealthSummary(ctx, req.(*GetDailyHealthSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_UpdateMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMedicalRecordRequest)
+func _HealthAnalyticsService_GetWeeklyHealthSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeeklyHealthSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).UpdateMedicalRecord(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).GetWeeklyHealthSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_UpdateMedicalRecord_FullMethodName,
+		FullMethod: HealthAnalyticsService_GetWeeklyHealthSummary_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).UpdateMedicalRecord(ctx, req.(*UpdateMedicalRecordRequest))
+		return srv.(HealthAnalyticsServiceServer).GetWeeklyHealthSummary(ctx, req.(*GetWeeklyHealthSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_DeleteMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMedicalRecordRequest)
+func _HealthAnalyticsService_UserIDHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).DeleteMedicalRecord(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).UserIDHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_DeleteMedicalRecord_FullMethodName,
+		FullMethod: HealthAnalyticsService_UserIDHealth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).DeleteMedicalRecord(ctx, req.(*DeleteMedicalRecordRequest))
+		return srv.(HealthAnalyticsServiceServer).UserIDHealth(ctx, req.(*UserIDHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_ListMedicalRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMedicalRecordsRequest)
+func _HealthAnalyticsService_CreateAlertThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertThresholdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).ListMedicalRecords(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).CreateAlertThreshold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_ListMedicalRecords_FullMethodName,
+		FullMethod: HealthAnalyticsService_CreateAlertThreshold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).ListMedicalRecords(ctx, req.(*ListMedicalRecordsRequest))
+		return srv.(HealthAnalyticsServiceServer).CreateAlertThreshold(ctx, req.(*CreateAlertThresholdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_AddLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddLifestyleDataRequest)
+func _HealthAnalyticsService_ListAlertThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertThresholdsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).AddLifestyleData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).ListAlertThresholds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_AddLifestyleData_FullMethodName,
+		FullMethod: HealthAnalyticsService_ListAlertThresholds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).AddLifestyleData(ctx, req.(*AddLifestyleDataRequest))
+		return srv.(HealthAnalyticsServiceServer).ListAlertThresholds(ctx, req.(*ListAlertThresholdsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLifestyleDataRequest)
+func _HealthAnalyticsService_UpdateAlertThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertThresholdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetLifestyleData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).UpdateAlertThreshold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetLifestyleData_FullMethodName,
+		FullMethod: HealthAnalyticsService_UpdateAlertThreshold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetLifestyleData(ctx, req.(*GetLifestyleDataRequest))
+		return srv.(HealthAnalyticsServiceServer).UpdateAlertThreshold(ctx, req.(*UpdateAlertThresholdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetAllLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllLifestyleDataRequest)
+func _HealthAnalyticsService_DeleteAlertThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertThresholdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetAllLifestyleData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).DeleteAlertThreshold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetAllLifestyleData_FullMethodName,
+		FullMethod: HealthAnalyticsService_DeleteAlertThreshold_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetAllLifestyleData(ctx, req.(*GetAllLifestyleDataRequest))
+		return srv.(HealthAnalyticsServiceServer).DeleteAlertThreshold(ctx, req.(*DeleteAlertThresholdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_UpdateLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLifestyleDataRequest)
+func _HealthAnalyticsService_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).UpdateLifestyleData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).ListAlerts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_UpdateLifestyleData_FullMethodName,
+		FullMethod: HealthAnalyticsService_ListAlerts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).UpdateLifestyleData(ctx, req.(*UpdateLifestyleDataRequest))
+		return srv.(HealthAnalyticsServiceServer).ListAlerts(ctx, req.(*ListAlertsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_DeleteLifestyleData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLifestyleDataRequest)
+func _HealthAnalyticsService_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).DeleteLifestyleData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).AcknowledgeAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_DeleteLifestyleData_FullMethodName,
+		FullMethod: HealthAnalyticsService_AcknowledgeAlert_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).DeleteLifestyleData(ctx, req.(*DeleteLifestyleDataRequest))
+		return srv.(HealthAnalyticsServiceServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_AddWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddWearableDataRequest)
+func _HealthAnalyticsService_GrantDoctorConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantDoctorConsentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).AddWearableData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).GrantDoctorConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_AddWearableData_FullMethodName,
+		FullMethod: HealthAnalyticsService_GrantDoctorConsent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).AddWearableData(ctx, req.(*AddWearableDataRequest))
+		return srv.(HealthAnalyticsServiceServer).GrantDoctorConsent(ctx, req.(*GrantDoctorConsentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWearableDataRequest)
+func _HealthAnalyticsService_RevokeDoctorConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDoctorConsentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetWearableData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).RevokeDoctorConsent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetWearableData_FullMethodName,
+		FullMethod: HealthAnalyticsService_RevokeDoctorConsent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetWearableData(ctx, req.(*GetWearableDataRequest))
+		return srv.(HealthAnalyticsServiceServer).RevokeDoctorConsent(ctx, req.(*RevokeDoctorConsentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetAllWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllWearableDataRequest)
+func _HealthAnalyticsService_ListDoctorConsents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDoctorConsentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetAllWearableData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).ListDoctorConsents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetAllWearableData_FullMethodName,
+		FullMethod: HealthAnalyticsService_ListDoctorConsents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetAllWearableData(ctx, req.(*GetAllWearableDataRequest))
+		return srv.(HealthAnalyticsServiceServer).ListDoctorConsents(ctx, req.(*ListDoctorConsentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_UpdateWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateWearableDataRequest)
+func _HealthAnalyticsService_ListPatientsForDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPatientsForDoctorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).UpdateWearableData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).ListPatientsForDoctor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_UpdateWearableData_FullMethodName,
+		FullMethod: HealthAnalyticsService_ListPatientsForDoctor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).UpdateWearableData(ctx, req.(*UpdateWearableDataRequest))
+		return srv.(HealthAnalyticsServiceServer).ListPatientsForDoctor(ctx, req.(*ListPatientsForDoctorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_DeleteWearableData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWearableDataRequest)
+func _HealthAnalyticsService_ListMedicalRecordsByDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMedicalRecordsByDoctorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).DeleteWearableData(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).ListMedicalRecordsByDoctor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_DeleteWearableData_FullMethodName,
+		FullMethod: HealthAnalyticsService_ListMedicalRecordsByDoctor_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).DeleteWearableData(ctx, req.(*DeleteWearableDataRequest))
+		return srv.(HealthAnalyticsServiceServer).ListMedicalRecordsByDoctor(ctx, req.(*ListMedicalRecordsByDoctorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GenerateHealthRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateHealthRecommendationsRequest)
+func _HealthAnalyticsService_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendations(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).QueryAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GenerateHealthRecommendations_FullMethodName,
+		FullMethod: HealthAnalyticsService_QueryAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendations(ctx, req.(*GenerateHealthRecommendationsRequest))
+		return srv.(HealthAnalyticsServiceServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GenerateHealthRecommendationsId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateHealthRecommendationsIdRequest)
+func _HealthAnalyticsService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendationsId(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).VerifyAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GenerateHealthRecommendationsId_FullMethodName,
+		FullMethod: HealthAnalyticsService_VerifyAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GenerateHealthRecommendationsId(ctx, req.(*GenerateHealthRecommendationsIdRequest))
+		return srv.(HealthAnalyticsServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetRealtimeHealthMonitoring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRealtimeHealthMonitoringRequest)
+func _HealthAnalyticsService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetRealtimeHealthMonitoring(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).ListDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetRealtimeHealthMonitoring_FullMethodName,
+		FullMethod: HealthAnalyticsService_ListDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetRealtimeHealthMonitoring(ctx, req.(*GetRealtimeHealthMonitoringRequest))
+		return srv.(HealthAnalyticsServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetDailyHealthSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDailyHealthSummaryRequest)
+func _HealthAnalyticsService_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetDailyHealthSummary(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).ReplayDeadLetters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetDailyHealthSummary_FullMethodName,
+		FullMethod: HealthAnalyticsService_ReplayDeadLetters_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetDailyHealthSummary(ctx, req.(*GetDailyHealthSummaryRequest))
+		return srv.(HealthAnalyticsServiceServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_GetWeeklyHealthSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWeeklyHealthSummaryRequest)
+func _HealthAnalyticsService_SetUserTimezone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserTimezoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).GetWeeklyHealthSummary(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).SetUserTimezone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_GetWeeklyHealthSummary_FullMethodName,
+		FullMethod: HealthAnalyticsService_SetUserTimezone_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).GetWeeklyHealthSummary(ctx, req.(*GetWeeklyHealthSummaryRequest))
+		return srv.(HealthAnalyticsServiceServer).SetUserTimezone(ctx, req.(*SetUserTimezoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _HealthAnalyticsService_UserIDHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRealtimeHealthMonitoringRequest)
+func _HealthAnalyticsService_GetUserTimezone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserTimezoneRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(HealthAnalyticsServiceServer).UserIDHealth(ctx, in)
+		return srv.(HealthAnalyticsServiceServer).GetUserTimezone(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: HealthAnalyticsService_UserIDHealth_FullMethodName,
+		FullMethod: HealthAnalyticsService_GetUserTimezone_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(HealthAnalyticsServiceServer).UserIDHealth(ctx, req.(*GetRealtimeHealthMonitoringRequest))
+		return srv.(HealthAnalyticsServiceServer).GetUserTimezone(ctx, req.(*GetUserTimezoneRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -813,6 +1862,18 @@ var HealthAnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMedicalRecords",
 			Handler:    _HealthAnalyticsService_ListMedicalRecords_Handler,
 		},
+		{
+			MethodName: "RestoreMedicalRecord",
+			Handler:    _HealthAnalyticsService_RestoreMedicalRecord_Handler,
+		},
+		{
+			MethodName: "ListDeletedMedicalRecords",
+			Handler:    _HealthAnalyticsService_ListDeletedMedicalRecords_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _HealthAnalyticsService_DeleteAttachment_Handler,
+		},
 		{
 			MethodName: "AddLifestyleData",
 			Handler:    _HealthAnalyticsService_AddLifestyleData_Handler,
@@ -833,10 +1894,22 @@ var HealthAnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLifestyleData",
 			Handler:    _HealthAnalyticsService_DeleteLifestyleData_Handler,
 		},
+		{
+			MethodName: "RestoreLifestyleData",
+			Handler:    _HealthAnalyticsService_RestoreLifestyleData_Handler,
+		},
+		{
+			MethodName: "ListDeletedLifestyleData",
+			Handler:    _HealthAnalyticsService_ListDeletedLifestyleData_Handler,
+		},
 		{
 			MethodName: "AddWearableData",
 			Handler:    _HealthAnalyticsService_AddWearableData_Handler,
 		},
+		{
+			MethodName: "AddWearableDataBatch",
+			Handler:    _HealthAnalyticsService_AddWearableDataBatch_Handler,
+		},
 		{
 			MethodName: "GetWearableData",
 			Handler:    _HealthAnalyticsService_GetWearableData_Handler,
@@ -853,6 +1926,14 @@ var HealthAnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWearableData",
 			Handler:    _HealthAnalyticsService_DeleteWearableData_Handler,
 		},
+		{
+			MethodName: "RestoreWearableData",
+			Handler:    _HealthAnalyticsService_RestoreWearableData_Handler,
+		},
+		{
+			MethodName: "ListDeletedWearableData",
+			Handler:    _HealthAnalyticsService_ListDeletedWearableData_Handler,
+		},
 		{
 			MethodName: "GenerateHealthRecommendations",
 			Handler:    _HealthAnalyticsService_GenerateHealthRecommendations_Handler,
@@ -877,7 +1958,91 @@ var HealthAnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserIDHealth",
 			Handler:    _HealthAnalyticsService_UserIDHealth_Handler,
 		},
+		{
+			MethodName: "CreateAlertThreshold",
+			Handler:    _HealthAnalyticsService_CreateAlertThreshold_Handler,
+		},
+		{
+			MethodName: "ListAlertThresholds",
+			Handler:    _HealthAnalyticsService_ListAlertThresholds_Handler,
+		},
+		{
+			MethodName: "UpdateAlertThreshold",
+			Handler:    _HealthAnalyticsService_UpdateAlertThreshold_Handler,
+		},
+		{
+			MethodName: "DeleteAlertThreshold",
+			Handler:    _HealthAnalyticsService_DeleteAlertThreshold_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _HealthAnalyticsService_ListAlerts_Handler,
+		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _HealthAnalyticsService_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "GrantDoctorConsent",
+			Handler:    _HealthAnalyticsService_GrantDoctorConsent_Handler,
+		},
+		{
+			MethodName: "RevokeDoctorConsent",
+			Handler:    _HealthAnalyticsService_RevokeDoctorConsent_Handler,
+		},
+		{
+			MethodName: "ListDoctorConsents",
+			Handler:    _HealthAnalyticsService_ListDoctorConsents_Handler,
+		},
+		{
+			MethodName: "ListPatientsForDoctor",
+			Handler:    _HealthAnalyticsService_ListPatientsForDoctor_Handler,
+		},
+		{
+			MethodName: "ListMedicalRecordsByDoctor",
+			Handler:    _HealthAnalyticsService_ListMedicalRecordsByDoctor_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _HealthAnalyticsService_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _HealthAnalyticsService_VerifyAuditLog_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _HealthAnalyticsService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _HealthAnalyticsService_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "SetUserTimezone",
+			Handler:    _HealthAnalyticsService_SetUserTimezone_Handler,
+		},
+		{
+			MethodName: "GetUserTimezone",
+			Handler:    _HealthAnalyticsService_GetUserTimezone_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _HealthAnalyticsService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _HealthAnalyticsService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamRealtimeHealthMonitoring",
+			Handler:       _HealthAnalyticsService_StreamRealtimeHealthMonitoring_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "Medicine_and_Health_protos/HealthAnalytics/Health_Analytics.proto",
 }
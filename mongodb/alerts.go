@@ -0,0 +1,511 @@
+package mongoDb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "health/genproto/health_analytics"
+	"health/pkg/auth"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// severityRank bir nechta chegara buzilganda eng jiddiysini tanlash uchun
+var severityRank = map[string]int{
+	"info":     1,
+	"warning":  2,
+	"critical": 3,
+}
+
+// alertThresholdDoc alert_thresholds kolleksiyasidagi hujjat
+type alertThresholdDoc struct {
+	Id        string    `bson:"id"`
+	UserId    string    `bson:"user_id"`
+	DataType  string    `bson:"data_type"`
+	MinValue  *float64  `bson:"min_value"`
+	MaxValue  *float64  `bson:"max_value"`
+	Severity  string    `bson:"severity"`
+	CreatedAt time.Time `bson:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+func (d alertThresholdDoc) toProto() *pb.AlertThreshold {
+	return &pb.AlertThreshold{
+		Id:        d.Id,
+		UserId:    d.UserId,
+		DataType:  d.DataType,
+		MinValue:  d.MinValue,
+		MaxValue:  d.MaxValue,
+		Severity:  d.Severity,
+		CreatedAt: protoTime(d.CreatedAt),
+		UpdatedAt: protoTime(d.UpdatedAt),
+	}
+}
+
+// alertDoc alerts kolleksiyasidagi hujjat
+type alertDoc struct {
+	Id             string  `bson:"id"`
+	UserId         string  `bson:"user_id"`
+	WearableDataId string  `bson:"wearable_data_id"`
+	DataType       string  `bson:"data_type"`
+	Value          float64 `bson:"value"`
+	ThresholdId    string  `bson:"threshold_id"`
+	Severity       string  `bson:"severity"`
+	Message        string  `bson:"message"`
+	Acknowledged   bool    `bson:"acknowledged"`
+	AcknowledgedBy string  `bson:"acknowledged_by"`
+	// AcknowledgedAt tasdiqlanmagan alertlarda null
+	AcknowledgedAt *time.Time `bson:"acknowledged_at"`
+	CreatedAt      time.Time  `bson:"created_at"`
+}
+
+func (d alertDoc) toProto() *pb.Alert {
+	return &pb.Alert{
+		Id:             d.Id,
+		UserId:         d.UserId,
+		WearableDataId: d.WearableDataId,
+		DataType:       d.DataType,
+		Value:          d.Value,
+		ThresholdId:    d.ThresholdId,
+		Severity:       d.Severity,
+		Message:        d.Message,
+		Acknowledged:   d.Acknowledged,
+		AcknowledgedBy: d.AcknowledgedBy,
+		AcknowledgedAt: protoTimePtr(d.AcknowledgedAt),
+		CreatedAt:      protoTime(d.CreatedAt),
+	}
+}
+
+func float(v float64) *float64 { return &v }
+
+// defaultAlertThresholds InitAlerts bazada yo'q bo'lsa yozadigan default chegaralar
+var defaultAlertThresholds = []alertThresholdDoc{
+	{DataType: "heart_rate", MinValue: float(50), MaxValue: float(120), Severity: "warning"},
+	{DataType: "heart_rate", MinValue: float(40), MaxValue: float(150), Severity: "critical"},
+	{DataType: "spo2", MinValue: float(94), Severity: "warning"},
+	{DataType: "spo2", MinValue: float(90), Severity: "critical"},
+	{DataType: "blood_pressure_systolic", MinValue: float(90), MaxValue: float(140), Severity: "warning"},
+	{DataType: "blood_pressure_systolic", MaxValue: float(180), Severity: "critical"},
+	{DataType: "blood_pressure_diastolic", MinValue: float(60), MaxValue: float(90), Severity: "warning"},
+	{DataType: "blood_pressure_diastolic", MaxValue: float(120), Severity: "critical"},
+}
+
+// InitAlerts default chegaralarni bazaga yozadi. Idempotent, har startda
+// chaqirilsa bo'ladi. Alert exchange i DeclareTopology da e'lon qilinadi.
+func (h *Health) InitAlerts(ctx context.Context) error {
+	coll := h.Db.Collection("alert_thresholds")
+	vaqt := now()
+	for _, t := range defaultAlertThresholds {
+		_, err := coll.UpdateOne(ctx,
+			bson.M{"user_id": "", "data_type": t.DataType, "severity": t.Severity, "deleted_at": nil},
+			bson.M{"$setOnInsert": bson.M{
+				"id":         uuid.NewString(),
+				"min_value":  t.MinValue,
+				"max_value":  t.MaxValue,
+				"created_at": vaqt,
+				"updated_at": vaqt,
+			}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to seed default alert threshold: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// readingComponents o'lchovni chegaralar tekshiriladigan data_type larga
+// ajratadi. Tarkibli qiymatlar "<data_type>_<component>" nomi bilan tekshiriladi,
+// masalan blood_pressure_systolic.
+func readingComponents(dataType string, m *pb.Measurement) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+	if len(m.Components) == 0 {
+		return map[string]float64{dataType: m.Value}
+	}
+
+	components := make(map[string]float64, len(m.Components))
+	for name, value := range m.Components {
+		components[dataType+"_"+name] = value
+	}
+	return components
+}
+
+// thresholdsFor foydalanuvchi uchun amal qiladigan chegaralar: data_type uchun
+// foydalanuvchining o'z chegarasi bo'lsa default chegaralar hisobga olinmaydi
+func (h *Health) thresholdsFor(ctx context.Context, userID, dataType string) ([]alertThresholdDoc, error) {
+	cursor, err := h.Db.Collection("alert_thresholds").Find(ctx, bson.M{
+		"user_id":    bson.M{"$in": bson.A{userID, ""}},
+		"data_type":  dataType,
+		"deleted_at": nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []alertThresholdDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	var own, defaults []alertThresholdDoc
+	for _, d := range docs {
+		if d.UserId == "" {
+			defaults = append(defaults, d)
+		} else {
+			own = append(own, d)
+		}
+	}
+	if len(own) > 0 {
+		return own, nil
+	}
+	return defaults, nil
+}
+
+// checkAlerts yangi o'lchovni chegaralar bilan solishtiradi. Buzilgan eng jiddiy
+// chegara uchun alert yaratiladi, health_alerts ga va realtime kanalga yuboriladi.
+func (h *Health) checkAlerts(ctx context.Context, wearableDataID, userID, dataType string, measurement *pb.Measurement) {
+	for component, value := range readingComponents(dataType, measurement) {
+		thresholds, err := h.thresholdsFor(ctx, userID, component)
+		if err != nil {
+			h.Logger.Error("Failed to load alert thresholds", "user_id", userID, "data_type", component, "error", err)
+			continue
+		}
+
+		var breached *alertThresholdDoc
+		for i, t := range thresholds {
+			below := t.MinValue != nil && value < *t.MinValue
+			above := t.MaxValue != nil && value > *t.MaxValue
+			if (below || above) && (breached == nil || severityRank[t.Severity] > severityRank[breached.Severity]) {
+				breached = &thresholds[i]
+			}
+		}
+		if breached == nil {
+			continue
+		}
+
+		alert := alertDoc{
+			Id:             uuid.NewString(),
+			UserId:         userID,
+			WearableDataId: wearableDataID,
+			DataType:       component,
+			Value:          value,
+			ThresholdId:    breached.Id,
+			Severity:       breached.Severity,
+			Message:        alertMessage(component, value, *breached),
+			CreatedAt:      now(),
+		}
+
+		if _, err := h.Db.Collection("alerts").InsertOne(ctx, alert); err != nil {
+			h.Logger.Error("Failed to insert alert into MongoDB", "user_id", userID, "error", err)
+			continue
+		}
+
+		body, err := protojson.Marshal(alert.toProto())
+		if err != nil {
+			h.Logger.Error("Failed to marshal alert", "error", err)
+			continue
+		}
+		if err := h.publishWithConfirm(ctx, h.Topology.AlertsExchange, "alert."+alert.Severity, body); err != nil {
+			h.Logger.Error("Failed to publish alert", "alert_id", alert.Id, "error", err)
+		}
+
+		h.publishRealtime(ctx, userID, &pb.RealtimeHealthEvent{
+			Event: &pb.RealtimeHealthEvent_Alert{Alert: alert.toProto()},
+		})
+	}
+}
+
+func alertMessage(dataType string, value float64, t alertThresholdDoc) string {
+	if t.MinValue != nil && value < *t.MinValue {
+		return fmt.Sprintf("%s qiymati %g, ruxsat etilgan minimum %g", dataType, value, *t.MinValue)
+	}
+	return fmt.Sprintf("%s qiymati %g, ruxsat etilgan maksimum %g", dataType, value, *t.MaxValue)
+}
+
+// authorizeThreshold default chegaralarni (user_id bo'sh) faqat admin o'zgartira oladi
+func authorizeThreshold(ctx context.Context, userID string) error {
+	if userID == "" {
+		return auth.RequireRole(ctx, auth.RoleAdmin)
+	}
+	return auth.Authorize(ctx, userID)
+}
+
+func validateThreshold(dataType, severity string, minValue, maxValue *float64) error {
+	if dataType == "" {
+		return status.Error(codes.InvalidArgument, "data_type bo'sh bo'lmasligi kerak")
+	}
+	if _, ok := severityRank[severity]; !ok {
+		return status.Errorf(codes.InvalidArgument, "severity info, warning yoki critical bo'lishi kerak: %q", severity)
+	}
+	if minValue == nil && maxValue == nil {
+		return status.Error(codes.InvalidArgument, "min_value yoki max_value berilishi kerak")
+	}
+	if minValue != nil && maxValue != nil && *minValue > *maxValue {
+		return status.Error(codes.InvalidArgument, "min_value max_value dan katta bo'lmasligi kerak")
+	}
+	return nil
+}
+
+// CreateAlertThreshold foydalanuvchi yoki (user_id bo'sh bo'lsa) default chegara qo'shadi
+func (h *Health) CreateAlertThreshold(ctx context.Context, req *pb.CreateAlertThresholdRequest) (*pb.CreateAlertThresholdResponse, error) {
+	if err := authorizeThreshold(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold(req.DataType, req.Severity, req.MinValue, req.MaxValue); err != nil {
+		return nil, err
+	}
+
+	vaqt := now()
+	threshold := alertThresholdDoc{
+		Id:        uuid.NewString(),
+		UserId:    req.UserId,
+		DataType:  req.DataType,
+		MinValue:  req.MinValue,
+		MaxValue:  req.MaxValue,
+		Severity:  req.Severity,
+		CreatedAt: vaqt,
+		UpdatedAt: vaqt,
+	}
+
+	_, err := h.Db.Collection("alert_thresholds").InsertOne(ctx, bson.M{
+		"id":         threshold.Id,
+		"user_id":    threshold.UserId,
+		"data_type":  threshold.DataType,
+		"min_value":  threshold.MinValue,
+		"max_value":  threshold.MaxValue,
+		"severity":   threshold.Severity,
+		"created_at": vaqt,
+		"updated_at": vaqt,
+		"deleted_at": nil,
+	})
+	if err != nil {
+		h.Logger.Error("Failed to add alert threshold", "error", err)
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditCreate, "alert_thresholds", threshold.Id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAlertThresholdResponse{Threshold: threshold.toProto()}, nil
+}
+
+// ListAlertThresholds foydalanuvchining o'z chegaralari va default chegaralar
+func (h *Health) ListAlertThresholds(ctx context.Context, req *pb.ListAlertThresholdsRequest) (*pb.ListAlertThresholdsResponse, error) {
+	cursor, err := h.Db.Collection("alert_thresholds").Find(ctx,
+		bson.M{"user_id": bson.M{"$in": bson.A{req.UserId, ""}}, "deleted_at": nil},
+		options.Find().SetSort(bson.D{{Key: "user_id", Value: -1}, {Key: "data_type", Value: 1}, {Key: "severity", Value: 1}}),
+	)
+	if err != nil {
+		h.Logger.Error("Failed to list alert thresholds", "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []alertThresholdDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		h.Logger.Error("Failed to decode alert thresholds", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListAlertThresholdsResponse{}
+	for _, d := range docs {
+		resp.Thresholds = append(resp.Thresholds, d.toProto())
+	}
+	return resp, nil
+}
+
+// UpdateAlertThreshold chegara qiymatlari va jiddiyligini yangilaydi
+func (h *Health) UpdateAlertThreshold(ctx context.Context, req *pb.UpdateAlertThresholdRequest) (*pb.UpdateAlertThresholdResponse, error) {
+	var existing alertThresholdDoc
+	err := h.Db.Collection("alert_thresholds").FindOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}).Decode(&existing)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			h.Logger.Warn("Alert threshold not found for update", "id", req.Id)
+			return &pb.UpdateAlertThresholdResponse{Success: false}, errors.New("ogohlantirish chegarasi topilmadi")
+		}
+		h.Logger.Error("Failed to get alert threshold", "error", err)
+		return nil, err
+	}
+
+	if err := authorizeThreshold(ctx, existing.UserId); err != nil {
+		return nil, err
+	}
+
+	if err := validateThreshold(existing.DataType, req.Severity, req.MinValue, req.MaxValue); err != nil {
+		return nil, err
+	}
+
+	_, err = h.Db.Collection("alert_thresholds").UpdateOne(ctx,
+		bson.M{"id": req.Id, "deleted_at": nil},
+		bson.M{"$set": bson.M{
+			"min_value":  req.MinValue,
+			"max_value":  req.MaxValue,
+			"severity":   req.Severity,
+			"updated_at": now(),
+		}},
+	)
+	if err != nil {
+		h.Logger.Error("Failed to update alert threshold", "error", err)
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditUpdate, "alert_thresholds", req.Id, existing.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateAlertThresholdResponse{Success: true}, nil
+}
+
+// DeleteAlertThreshold chegarani o'chirilgan deb belgilaydi
+func (h *Health) DeleteAlertThreshold(ctx context.Context, req *pb.DeleteAlertThresholdRequest) (*pb.DeleteAlertThresholdResponse, error) {
+	var existing alertThresholdDoc
+	err := h.Db.Collection("alert_thresholds").FindOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}).Decode(&existing)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		h.Logger.Error("Failed to get alert threshold", "error", err)
+		return nil, err
+	}
+	if err == nil {
+		if err := authorizeThreshold(ctx, existing.UserId); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := h.Db.Collection("alert_thresholds").UpdateOne(ctx,
+		bson.M{"id": req.Id, "deleted_at": nil},
+		bson.M{"$set": bson.M{"deleted_at": now()}},
+	)
+	if err != nil {
+		h.Logger.Error("Failed to delete alert threshold", "error", err)
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		h.Logger.Warn("Alert threshold not found for deletion", "id", req.Id)
+		return &pb.DeleteAlertThresholdResponse{Success: false}, errors.New("ogohlantirish chegarasi topilmadi")
+	}
+
+	if err := h.audit(ctx, auditDelete, "alert_thresholds", req.Id, existing.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAlertThresholdResponse{Success: true}, nil
+}
+
+// listAlertsCursor ListAlerts sahifasidagi oxirgi alertning saralash kalitlari
+type listAlertsCursor struct {
+	CreatedAt time.Time `json:"c"`
+	Id        string    `json:"i"`
+}
+
+// ListAlerts foydalanuvchi alertlarini yangilaridan boshlab sahifalab qaytaradi
+func (h *Health) ListAlerts(ctx context.Context, req *pb.ListAlertsRequest) (*pb.ListAlertsResponse, error) {
+	filter := bson.M{"user_id": req.UserId}
+	if req.UnacknowledgedOnly {
+		filter["acknowledged"] = false
+	}
+	if req.Cursor != "" {
+		var c listAlertsCursor
+		if err := decodeCursor(req.Cursor, &c); err != nil {
+			return nil, err
+		}
+		filter["$or"] = []bson.M{
+			{"created_at": bson.M{"$lt": c.CreatedAt}},
+			{"created_at": c.CreatedAt, "id": bson.M{"$lt": c.Id}},
+		}
+	}
+
+	limit := pageSize(req.Limit)
+	cursor, err := h.Db.Collection("alerts").Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "id", Value: -1}}).
+			SetLimit(limit+1),
+	)
+	if err != nil {
+		h.Logger.Error("Failed to list alerts", "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []alertDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		h.Logger.Error("Failed to decode alerts", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListAlertsResponse{}
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		last := docs[len(docs)-1]
+		resp.NextCursor, err = encodeCursor(listAlertsCursor{CreatedAt: last.CreatedAt, Id: last.Id})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range docs {
+		resp.Alerts = append(resp.Alerts, d.toProto())
+	}
+
+	if err := h.audit(ctx, auditList, "alerts", "", req.UserId); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// acknowledgedAlertRetention tasdiqlangan alertlar shuncha vaqtdan keyin
+// expires_at ustidagi TTL index orqali o'chiriladi
+const acknowledgedAlertRetention = 90 * 24 * time.Hour
+
+// AcknowledgeAlert alertni so'rov egasi ko'rib chiqdi deb belgilaydi
+func (h *Health) AcknowledgeAlert(ctx context.Context, req *pb.AcknowledgeAlertRequest) (*pb.AcknowledgeAlertResponse, error) {
+	owner, err := h.authorizeOwner(ctx, "alerts", req.Id, "ogohlantirish topilmadi")
+	if err != nil {
+		return nil, err
+	}
+	// acknowledged_by audit uchun muhim, shuning uchun so'rovdan emas tokendan olinadi
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "avtorizatsiyadan o'tilmagan")
+	}
+	if req.AcknowledgedBy != "" && req.AcknowledgedBy != p.UserID {
+		return nil, status.Error(codes.InvalidArgument, "acknowledged_by so'rov egasi bilan bir xil bo'lishi kerak")
+	}
+
+	result, err := h.Db.Collection("alerts").UpdateOne(ctx,
+		bson.M{"id": req.Id},
+		bson.M{"$set": bson.M{
+			"acknowledged":    true,
+			"acknowledged_by": p.UserID,
+			"acknowledged_at": now(),
+			"expires_at":      now().Add(acknowledgedAlertRetention),
+		}},
+	)
+	if err != nil {
+		h.Logger.Error("Failed to acknowledge alert", "error", err)
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		h.Logger.Warn("Alert not found", "id", req.Id)
+		return &pb.AcknowledgeAlertResponse{Success: false}, errors.New("ogohlantirish topilmadi")
+	}
+
+	if err := h.audit(ctx, auditUpdate, "alerts", req.Id, owner); err != nil {
+		return nil, err
+	}
+
+	return &pb.AcknowledgeAlertResponse{Success: true}, nil
+}
@@ -0,0 +1,534 @@
+package mongoDb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	pb "health/genproto/health_analytics"
+	"health/pkg/envelope"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultAttachmentMaxBytes bitta fayl uchun standart hajm chegarasi
+const DefaultAttachmentMaxBytes = 20 << 20
+
+// attachmentFrameSize shifrlangan fayl shu hajmdagi bo'laklarga bo'linib
+// alohida muhrlanadi, shunda yuklash va yuklab olish xotirada butun faylni
+// ushlamaydi
+const attachmentFrameSize = 64 << 10
+
+// sealOverhead AES-GCM nonce va tag uzunligi
+const sealOverhead = 12 + 16
+
+// allowedAttachmentTypes fayl mazmunidan aniqlangan, qabul qilinadigan turlar
+var allowedAttachmentTypes = map[string]bool{
+	"application/pdf":           true,
+	"application/dicom":         true,
+	"image/png":                 true,
+	"image/jpeg":                true,
+	"image/gif":                 true,
+	"image/webp":                true,
+	"text/plain; charset=utf-8": true,
+}
+
+// sniffContentType fayl turini mijoz aytganiga emas, mazmuniga qarab aniqlaydi.
+// DICOM faylida 128 bayt preambuladan keyin "DICM" belgisi bo'ladi.
+func sniffContentType(head []byte) string {
+	if len(head) >= 132 && string(head[128:132]) == "DICM" {
+		return "application/dicom"
+	}
+	return http.DetectContentType(head)
+}
+
+func (h *Health) attachmentBucket(ctx context.Context) (*gridfs.Bucket, error) {
+	bucket, err := gridfs.NewBucket(h.Db, options.GridFSBucket().SetName("attachments"))
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		bucket.SetWriteDeadline(deadline)
+		bucket.SetReadDeadline(deadline)
+	}
+	return bucket, nil
+}
+
+func frameAAD(fileID string, index uint64, last bool) []byte {
+	return []byte(fmt.Sprintf("attachments/%s/%d/%t", fileID, index, last))
+}
+
+// frameWriter faylni attachmentFrameSize li bo'laklarga bo'lib shifrlaydi.
+// Oxirgi bo'lak AAD da belgilanadi, shuning uchun fayl oxiridan qirqilsa
+// yuklab olishda aniqlanadi.
+type frameWriter struct {
+	w      io.Writer
+	key    []byte
+	fileID string
+	buf    []byte
+	index  uint64
+}
+
+func (f *frameWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		if len(f.buf) == attachmentFrameSize {
+			if err := f.flush(false); err != nil {
+				return 0, err
+			}
+		}
+		k := min(attachmentFrameSize-len(f.buf), len(p))
+		f.buf = append(f.buf, p[:k]...)
+		p = p[k:]
+	}
+	return n, nil
+}
+
+func (f *frameWriter) flush(last bool) error {
+	sealed, err := envelope.Seal(f.key, f.buf, frameAAD(f.fileID, f.index, last))
+	if err != nil {
+		return err
+	}
+	if _, err := f.w.Write(sealed); err != nil {
+		return err
+	}
+	f.index++
+	f.buf = f.buf[:0]
+	return nil
+}
+
+func (f *frameWriter) Close() error {
+	return f.flush(true)
+}
+
+// openFrames frameWriter yozgan bo'laklarni ketma-ket ochib fn ga beradi
+func openFrames(r io.Reader, key []byte, fileID string, fn func([]byte) error) error {
+	buf := make([]byte, attachmentFrameSize+sealOverhead)
+	n, err := io.ReadFull(r, buf)
+	if err == io.EOF {
+		return errors.New("shifrlangan fayl bo'sh")
+	}
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	for index := uint64(0); ; index++ {
+		last := err == io.ErrUnexpectedEOF
+		var next []byte
+		var nextN int
+		var nextErr error
+		if !last {
+			next = make([]byte, attachmentFrameSize+sealOverhead)
+			nextN, nextErr = io.ReadFull(r, next)
+			if nextErr == io.EOF {
+				last = true
+			} else if nextErr != nil && nextErr != io.ErrUnexpectedEOF {
+				return nextErr
+			}
+		}
+
+		plain, openErr := envelope.Open(key, buf[:n], frameAAD(fileID, index, last))
+		if openErr != nil {
+			return fmt.Errorf("fayl bo'lagi %d ochilmadi: %v", index, openErr)
+		}
+		if err := fn(plain); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+		buf, n, err = next, nextN, nextErr
+	}
+}
+
+// attachmentFileMeta GridFS fayl hujjatidagi metadata
+type attachmentFileMeta struct {
+	UserId      string `bson:"user_id"`
+	RecordId    string `bson:"record_id"`
+	ContentType string `bson:"content_type"`
+	Size        int64  `bson:"size"`
+	Sha256      string `bson:"sha256"`
+	// KeyVersion 0 bo'lsa fayl shifrlanmagan
+	KeyVersion int `bson:"key_version"`
+}
+
+// UploadAttachment tibbiy yozuvga fayl yuklaydi. Birinchi xabarda yozuv id si va
+// fayl nomi, keyingilarida fayl bo'laklari keladi. Fayl turi mazmunidan
+// aniqlanadi, hajmi AttachmentMaxBytes dan oshmasligi kerak.
+func (h *Health) UploadAttachment(stream pb.HealthAnalyticsService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil || info.RecordId == "" || info.Filename == "" {
+		return status.Error(codes.InvalidArgument, "birinchi xabarda record_id va filename bo'lishi kerak")
+	}
+
+	owner, err := h.authorizeOwner(ctx, "medical_records", info.RecordId, "tibbiy yozuv topilmadi")
+	if err != nil {
+		return err
+	}
+
+	// Turini aniqlash uchun boshidagi 512 baytni yig'amiz
+	var head []byte
+	eof := false
+	for len(head) < 512 {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			eof = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+		head = append(head, msg.GetChunk()...)
+	}
+	if len(head) == 0 {
+		return status.Error(codes.InvalidArgument, "fayl bo'sh")
+	}
+	contentType := sniffContentType(head)
+	if !allowedAttachmentTypes[contentType] {
+		return status.Errorf(codes.InvalidArgument, "fayl turi qabul qilinmaydi: %s", contentType)
+	}
+
+	bucket, err := h.attachmentBucket(ctx)
+	if err != nil {
+		return err
+	}
+	id := uuid.New().String()
+	upload, err := bucket.OpenUploadStreamWithID(id, id)
+	if err != nil {
+		h.Logger.Error("Failed to open attachment upload stream", "error", err)
+		return err
+	}
+
+	meta := attachmentFileMeta{UserId: owner, RecordId: info.RecordId, ContentType: contentType}
+	var w io.WriteCloser = nopWriteCloser{upload}
+	if h.Keys != nil {
+		version, key, err := h.currentDataKey(ctx, owner)
+		if err != nil {
+			upload.Abort()
+			h.Logger.Error("Failed to get data key", "user_id", owner, "error", err)
+			return status.Error(codes.Internal, "faylni shifrlab bo'lmadi")
+		}
+		meta.KeyVersion = version
+		w = &frameWriter{w: upload, key: key, fileID: id}
+	}
+
+	sum := sha256.New()
+	write := func(chunk []byte) error {
+		meta.Size += int64(len(chunk))
+		if meta.Size > h.AttachmentMaxBytes {
+			return status.Errorf(codes.InvalidArgument, "fayl hajmi %d baytdan oshmasligi kerak", h.AttachmentMaxBytes)
+		}
+		sum.Write(chunk)
+		_, err := w.Write(chunk)
+		return err
+	}
+
+	err = write(head)
+	for err == nil && !eof {
+		var msg *pb.UploadAttachmentRequest
+		msg, err = stream.Recv()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err == nil {
+			err = write(msg.GetChunk())
+		}
+	}
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		upload.Abort()
+		return err
+	}
+	if err := upload.Close(); err != nil {
+		h.Logger.Error("Failed to store attachment", "error", err)
+		return err
+	}
+
+	meta.Sha256 = hex.EncodeToString(sum.Sum(nil))
+	if info.Sha256 != "" && !strings.EqualFold(info.Sha256, meta.Sha256) {
+		h.deleteAttachmentFile(ctx, bucket, id)
+		return status.Error(codes.InvalidArgument, "sha256 yuklangan fayl bilan mos kelmadi")
+	}
+
+	if _, err := bucket.GetFilesCollection().UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"metadata": meta}}); err != nil {
+		h.deleteAttachmentFile(ctx, bucket, id)
+		h.Logger.Error("Failed to save attachment metadata", "error", err)
+		return err
+	}
+
+	attachment := attachmentDoc{
+		Id:          id,
+		Filename:    info.Filename,
+		ContentType: contentType,
+		Size:        meta.Size,
+		Sha256:      meta.Sha256,
+		UploadedAt:  now(),
+	}
+	stored := attachment
+	stored.Filename, err = h.encryptField(ctx, owner, "attachments", info.Filename)
+	if err != nil {
+		h.deleteAttachmentFile(ctx, bucket, id)
+		return err
+	}
+
+	result, err := h.Db.Collection("medical_records").UpdateOne(ctx,
+		bson.M{"id": info.RecordId, "deleted_at": nil},
+		bson.M{"$push": bson.M{"attachments": stored}, "$set": bson.M{"updated_at": attachment.UploadedAt}},
+	)
+	if err == nil && result.MatchedCount == 0 {
+		err = errors.New("tibbiy yozuv topilmadi")
+	}
+	if err != nil {
+		h.deleteAttachmentFile(ctx, bucket, id)
+		h.Logger.Error("Failed to attach file to medical record", "record_id", info.RecordId, "error", err)
+		return err
+	}
+
+	if err := h.audit(ctx, auditCreate, "attachments", id, owner); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&pb.UploadAttachmentResponse{Attachment: attachment.toProto()})
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// DownloadAttachment faylni yuklab beradi: birinchi xabarda fayl ma'lumotlari,
+// keyin bo'laklar. Oxirida checksum tekshiriladi, mos kelmasa DataLoss qaytadi.
+func (h *Health) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.HealthAnalyticsService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+
+	var record medicalRecordDoc
+	err := h.Db.Collection("medical_records").FindOne(ctx, bson.M{"id": req.RecordId, "deleted_at": nil}).Decode(&record)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			h.Logger.Warn("Medical record not found", "record_id", req.RecordId)
+			return status.Error(codes.NotFound, "tibbiy yozuv topilmadi")
+		}
+		h.Logger.Error("Failed to get medical record", "error", err)
+		return err
+	}
+
+	if err := authorizeRead(ctx, record.UserId, "tibbiy yozuv topilmadi"); err != nil {
+		return err
+	}
+
+	var attachment *attachmentDoc
+	for i := range record.Attachments {
+		if record.Attachments[i].Id == req.AttachmentId && req.AttachmentId != "" {
+			attachment = &record.Attachments[i]
+			break
+		}
+	}
+	if attachment == nil {
+		h.Logger.Warn("Attachment not found", "record_id", req.RecordId, "attachment_id", req.AttachmentId)
+		return errors.New("fayl topilmadi")
+	}
+	if attachment.Filename, err = h.decryptField(ctx, record.UserId, "attachments", attachment.Filename); err != nil {
+		h.Logger.Error("Failed to decrypt attachment filename", "attachment_id", attachment.Id, "error", err)
+		return status.Error(codes.Internal, "faylni ochib bo'lmadi")
+	}
+
+	bucket, err := h.attachmentBucket(ctx)
+	if err != nil {
+		return err
+	}
+	download, err := bucket.OpenDownloadStream(attachment.Id)
+	if err != nil {
+		h.Logger.Error("Failed to open attachment", "attachment_id", attachment.Id, "error", err)
+		return err
+	}
+	defer download.Close()
+
+	var meta attachmentFileMeta
+	if raw := download.GetFile().Metadata; raw != nil {
+		if err := bson.Unmarshal(raw, &meta); err != nil {
+			return err
+		}
+	}
+
+	if err := h.audit(ctx, auditGet, "attachments", attachment.Id, record.UserId); err != nil {
+		return err
+	}
+
+	if err := stream.Send(&pb.DownloadAttachmentResponse{Data: &pb.DownloadAttachmentResponse_Attachment{Attachment: attachment.toProto()}}); err != nil {
+		return err
+	}
+
+	sum := sha256.New()
+	send := func(chunk []byte) error {
+		sum.Write(chunk)
+		return stream.Send(&pb.DownloadAttachmentResponse{Data: &pb.DownloadAttachmentResponse_Chunk{Chunk: chunk}})
+	}
+	if meta.KeyVersion > 0 {
+		key, err := h.dataKey(ctx, record.UserId, meta.KeyVersion)
+		if err != nil {
+			h.Logger.Error("Failed to get data key", "user_id", record.UserId, "error", err)
+			return status.Error(codes.Internal, "faylni ochib bo'lmadi")
+		}
+		err = openFrames(download, key, attachment.Id, send)
+	} else {
+		err = copyChunks(download, send)
+	}
+	if err != nil {
+		h.Logger.Error("Failed to stream attachment", "attachment_id", attachment.Id, "error", err)
+		return err
+	}
+
+	return verifyChecksum(sum, attachment.Sha256)
+}
+
+func copyChunks(r io.Reader, fn func([]byte) error) error {
+	buf := make([]byte, attachmentFrameSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			// fn bo'lakni yuborib bo'lguncha buf qayta ishlatilmaydi
+			if err := fn(bytes.Clone(buf[:n])); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func verifyChecksum(sum hash.Hash, expected string) error {
+	if expected != "" && hex.EncodeToString(sum.Sum(nil)) != expected {
+		return status.Error(codes.DataLoss, "fayl checksum i mos kelmadi")
+	}
+	return nil
+}
+
+// DeleteAttachment faylni yozuvdan olib tashlaydi va GridFS dan o'chiradi
+func (h *Health) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	owner, err := h.authorizeOwner(ctx, "medical_records", req.RecordId, "tibbiy yozuv topilmadi")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.Db.Collection("medical_records").UpdateOne(ctx,
+		bson.M{"id": req.RecordId, "deleted_at": nil, "attachments.id": req.AttachmentId},
+		bson.M{
+			"$pull": bson.M{"attachments": bson.M{"id": req.AttachmentId}},
+			"$set":  bson.M{"updated_at": now()},
+		},
+	)
+	if err != nil {
+		h.Logger.Error("Failed to delete attachment", "error", err)
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		h.Logger.Warn("Attachment not found for deletion", "record_id", req.RecordId, "attachment_id", req.AttachmentId)
+		return &pb.DeleteAttachmentResponse{Success: false}, errors.New("fayl topilmadi")
+	}
+
+	bucket, err := h.attachmentBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.deleteAttachmentFile(ctx, bucket, req.AttachmentId)
+
+	if err := h.audit(ctx, auditDelete, "attachments", req.AttachmentId, owner); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAttachmentResponse{Success: true}, nil
+}
+
+// deleteAttachmentFile GridFS dan faylni o'chiradi. Xatolik faqat log ga
+// yoziladi: yozuvda havolasi qolmagan fayl hech kimga ko'rinmaydi.
+func (h *Health) deleteAttachmentFile(ctx context.Context, bucket *gridfs.Bucket, id string) {
+	if err := bucket.DeleteContext(ctx, id); err != nil && !errors.Is(err, gridfs.ErrFileNotFound) {
+		h.Logger.Error("Failed to delete attachment file", "attachment_id", id, "error", err)
+	}
+}
+
+// purgeAttachments butunlay o'chiriladigan tibbiy yozuvlarning fayllarini
+// GridFS dan o'chiradi
+func (h *Health) purgeAttachments(ctx context.Context, filter bson.M) error {
+	cursor, err := h.Db.Collection("medical_records").Find(ctx, filter,
+		options.Find().SetProjection(bson.M{"attachments.id": 1}),
+	)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	bucket, err := h.attachmentBucket(ctx)
+	if err != nil {
+		return err
+	}
+	for cursor.Next(ctx) {
+		var record struct {
+			Attachments []attachmentDoc `bson:"attachments"`
+		}
+		if err := cursor.Decode(&record); err != nil {
+			return err
+		}
+		for _, a := range record.Attachments {
+			if a.Id != "" {
+				h.deleteAttachmentFile(ctx, bucket, a.Id)
+			}
+		}
+	}
+	return cursor.Err()
+}
+
+// structureAttachments eski yozuvlardagi satr ko'rinishidagi attachments ni
+// {filename} hujjatlariga aylantiradi. Ularning ortida fayl yo'q, shuning uchun
+// id bo'sh qoladi. Shifrlangan satrlar shu holicha ko'chadi: filename ham
+// "attachments" maydoni kaliti bilan shifrlanadi.
+func structureAttachments(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	result, err := db.Collection("medical_records").UpdateMany(ctx,
+		bson.M{"attachments": bson.M{"$elemMatch": bson.M{"$type": "string"}}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"attachments": bson.M{"$map": bson.M{
+				"input": "$attachments",
+				"as":    "a",
+				"in": bson.M{"$cond": bson.A{
+					bson.M{"$eq": bson.A{bson.M{"$type": "$$a"}, "string"}},
+					bson.M{"id": "", "filename": "$$a"},
+					"$$a",
+				}},
+			}},
+		}}}},
+	)
+	if err != nil {
+		return err
+	}
+	log.Info("Converted legacy attachments", "modified", result.ModifiedCount)
+	return nil
+}
@@ -0,0 +1,333 @@
+package mongoDb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	pb "health/genproto/health_analytics"
+	"health/pkg/auth"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	auditCreate  = "create"
+	auditGet     = "get"
+	auditList    = "list"
+	auditUpdate  = "update"
+	auditDelete  = "delete"
+	auditRestore = "restore"
+)
+
+// auditTimeLayout qat'iy uzunlikdagi UTC vaqt, satr sifatida solishtirsa ham
+// tartib to'g'ri bo'ladi. Boshqa kolleksiyalardan farqli timestamp BSON Date
+// emas: u hash ga kiradi, Date ga o'tkazish nanosekundlarni yo'qotib mavjud
+// zanjirni buzadi.
+const auditTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// auditAppendRetries boshqa replika bilan bir vaqtda yozilganda seq to'qnashuvi
+// bo'lsa zanjir boshini qayta o'qib urinishlar soni
+const auditAppendRetries = 10
+
+// auditEntryDoc audit_log kolleksiyasidagi yozuv. Kolleksiyaga faqat qo'shiladi:
+// hash oldingi yozuvning hash i va shu yozuv maydonlaridan hisoblanadi, shuning
+// uchun o'rtadagi yozuv o'zgartirilsa yoki o'chirilsa VerifyAuditLog buni topadi.
+type auditEntryDoc struct {
+	Seq          int64             `bson:"seq" json:"seq"`
+	Id           string            `bson:"id" json:"id"`
+	ActorId      string            `bson:"actor_id" json:"actor_id"`
+	ActorRole    string            `bson:"actor_role" json:"actor_role"`
+	Action       string            `bson:"action" json:"action"`
+	ResourceType string            `bson:"resource_type" json:"resource_type"`
+	ResourceId   string            `bson:"resource_id" json:"resource_id"`
+	UserId       string            `bson:"user_id" json:"user_id"`
+	Timestamp    string            `bson:"timestamp" json:"timestamp"`
+	Metadata     map[string]string `bson:"metadata" json:"metadata"`
+	PrevHash     string            `bson:"prev_hash" json:"prev_hash"`
+	Hash         string            `bson:"hash" json:"-"`
+}
+
+// computeHash hash dan boshqa barcha maydonlarning JSON ko'rinishidan
+// sha256. encoding/json maydonlarni tartib bilan, map kalitlarini saralab yozadi.
+func (d auditEntryDoc) computeHash() string {
+	data, _ := json.Marshal(d)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// chainedTo yozuvni prev dan keyingi yozuv sifatida zanjirga ulaydi: seq,
+// prev_hash va hash to'ldiriladi. Zanjir bo'sh bo'lsa prev nol qiymat.
+func (d auditEntryDoc) chainedTo(prev auditEntryDoc) auditEntryDoc {
+	d.Seq = prev.Seq + 1
+	d.PrevHash = prev.Hash
+	d.Hash = d.computeHash()
+	return d
+}
+
+// follows yozuv zanjirda seq o'rnida, hash i prevHash li yozuvdan keyin turishi
+// va o'zgartirilmaganini tekshiradi
+func (d auditEntryDoc) follows(seq int64, prevHash string) bool {
+	return d.Seq == seq && d.PrevHash == prevHash && d.computeHash() == d.Hash
+}
+
+func (d auditEntryDoc) toProto() *pb.AuditLogEntry {
+	return &pb.AuditLogEntry{
+		Seq:          d.Seq,
+		Id:           d.Id,
+		ActorId:      d.ActorId,
+		ActorRole:    d.ActorRole,
+		Action:       d.Action,
+		ResourceType: d.ResourceType,
+		ResourceId:   d.ResourceId,
+		UserId:       d.UserId,
+		Timestamp:    auditTimeProto(d.Timestamp),
+		Metadata:     d.Metadata,
+		PrevHash:     d.PrevHash,
+		Hash:         d.Hash,
+	}
+}
+
+func auditTimeProto(value string) *timestamppb.Timestamp {
+	t, err := time.Parse(auditTimeLayout, value)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// requestMetadata so'rov haqidagi ma'lumotlar: gRPC metodi, mijoz manzili,
+// user-agent va x-request-id
+func requestMetadata(ctx context.Context) map[string]string {
+	meta := make(map[string]string)
+	if method, ok := grpc.Method(ctx); ok {
+		meta["method"] = method
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		meta["peer"] = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range []string{"user-agent", "x-request-id", "x-forwarded-for"} {
+			if values := md.Get(key); len(values) > 0 {
+				meta[key] = values[0]
+			}
+		}
+	}
+	return meta
+}
+
+// audit murojaatni audit_log zanjiriga qo'shadi. Yozib bo'lmasa xatolik
+// qaytadi va metod ham xatolik qaytaradi: izsiz murojaat bo'lmasligi kerak.
+func (h *Health) audit(ctx context.Context, action, resourceType, resourceID, userID string) error {
+	entry := auditEntryDoc{
+		Id:           uuid.NewString(),
+		ActorId:      "system",
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceId:   resourceID,
+		UserId:       userID,
+		Timestamp:    time.Now().UTC().Format(auditTimeLayout),
+		Metadata:     requestMetadata(ctx),
+	}
+	if p, ok := auth.FromContext(ctx); ok {
+		entry.ActorId, entry.ActorRole = p.UserID, p.Role
+	}
+
+	// Bitta replika ichida navbat bilan yozamiz, replikalar orasidagi
+	// to'qnashuvni seq ustidagi unique index hal qiladi
+	h.auditMu.Lock()
+	defer h.auditMu.Unlock()
+
+	coll := h.Db.Collection("audit_log")
+	for attempt := 0; attempt < auditAppendRetries; attempt++ {
+		var last auditEntryDoc
+		err := coll.FindOne(ctx, bson.M{}, options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}})).Decode(&last)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			h.Logger.Error("Failed to read audit log head", "error", err)
+			return status.Error(codes.Internal, "audit yozuvi saqlanmadi")
+		}
+
+		entry = entry.chainedTo(last)
+
+		_, err = coll.InsertOne(ctx, entry)
+		if err == nil {
+			return nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			h.Logger.Error("Failed to append audit log entry", "error", err)
+			return status.Error(codes.Internal, "audit yozuvi saqlanmadi")
+		}
+	}
+
+	h.Logger.Error("Audit log append kept conflicting", "action", action, "resource_type", resourceType, "resource_id", resourceID)
+	return status.Error(codes.Internal, "audit yozuvi saqlanmadi")
+}
+
+// createAuditIndexes seq bo'yicha unique index zanjirning tarmoqlanishiga yo'l
+// qo'ymaydi, qolganlari QueryAuditLog filtrlari uchun
+func createAuditIndexes(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	names, err := db.Collection("audit_log").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "seq", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "seq", Value: -1}}},
+		{Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "seq", Value: -1}}},
+		{Keys: bson.D{{Key: "resource_type", Value: 1}, {Key: "resource_id", Value: 1}, {Key: "seq", Value: -1}}},
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("Created audit log indexes", "indexes", names)
+	return nil
+}
+
+type auditLogCursor struct {
+	Seq int64 `json:"s"`
+}
+
+// QueryAuditLog audit yozuvlarini yangilaridan boshlab filtrlab qaytaradi.
+// Faqat compliance va admin rollari uchun.
+func (h *Health) QueryAuditLog(ctx context.Context, req *pb.QueryAuditLogRequest) (*pb.QueryAuditLogResponse, error) {
+	if err := auth.RequireRole(ctx, auth.RoleCompliance, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	for field, value := range map[string]string{
+		"actor_id":      req.ActorId,
+		"user_id":       req.UserId,
+		"resource_type": req.ResourceType,
+		"resource_id":   req.ResourceId,
+		"action":        req.Action,
+	} {
+		if value != "" {
+			filter[field] = value
+		}
+	}
+
+	timeRange := bson.M{}
+	for op, ts := range map[string]*timestamppb.Timestamp{"$gte": req.From, "$lt": req.To} {
+		if ts == nil {
+			continue
+		}
+		if err := ts.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "from va to noto'g'ri: %v", err)
+		}
+		timeRange[op] = ts.AsTime().UTC().Format(auditTimeLayout)
+	}
+	if len(timeRange) > 0 {
+		filter["timestamp"] = timeRange
+	}
+
+	if req.Cursor != "" {
+		var c auditLogCursor
+		if err := decodeCursor(req.Cursor, &c); err != nil {
+			return nil, err
+		}
+		filter["seq"] = bson.M{"$lt": c.Seq}
+	}
+
+	limit := pageSize(req.Limit)
+	cursor, err := h.Db.Collection("audit_log").Find(ctx, filter,
+		options.Find().SetSort(bson.D{{Key: "seq", Value: -1}}).SetLimit(limit+1),
+	)
+	if err != nil {
+		h.Logger.Error("Failed to query audit log", "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []auditEntryDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		h.Logger.Error("Failed to decode audit log entries", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.QueryAuditLogResponse{}
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		resp.NextCursor, err = encodeCursor(auditLogCursor{Seq: docs[len(docs)-1].Seq})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range docs {
+		resp.Entries = append(resp.Entries, d.toProto())
+	}
+
+	return resp, nil
+}
+
+// VerifyAuditLog zanjirni seq tartibida tekshiradi: har bir yozuvning hash i
+// qayta hisoblanadi, prev_hash oldingi yozuvga mos kelishi va seq uzilmasligi kerak
+func (h *Health) VerifyAuditLog(ctx context.Context, req *pb.VerifyAuditLogRequest) (*pb.VerifyAuditLogResponse, error) {
+	if err := auth.RequireRole(ctx, auth.RoleCompliance, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	coll := h.Db.Collection("audit_log")
+	from := req.FromSeq
+	if from < 1 {
+		from = 1
+	}
+
+	// Birinchi tekshiriladigan yozuvning prev_hash i oldingi yozuvdan olinadi
+	var prevHash string
+	if from > 1 {
+		var prev auditEntryDoc
+		if err := coll.FindOne(ctx, bson.M{"seq": from - 1}).Decode(&prev); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return &pb.VerifyAuditLogResponse{Valid: false, FirstInvalidSeq: from - 1}, nil
+			}
+			return nil, err
+		}
+		prevHash = prev.Hash
+	}
+
+	seqRange := bson.M{"$gte": from}
+	if req.ToSeq > 0 {
+		seqRange["$lte"] = req.ToSeq
+	}
+	cursor, err := coll.Find(ctx, bson.M{"seq": seqRange}, options.Find().SetSort(bson.D{{Key: "seq", Value: 1}}))
+	if err != nil {
+		h.Logger.Error("Failed to read audit log for verification", "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	resp := &pb.VerifyAuditLogResponse{Valid: true}
+	expected := from
+	for cursor.Next(ctx) {
+		var entry auditEntryDoc
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, err
+		}
+
+		if !entry.follows(expected, prevHash) {
+			resp.Valid = false
+			resp.FirstInvalidSeq = expected
+			h.Logger.Warn("Audit log chain broken", "seq", expected)
+			return resp, nil
+		}
+
+		resp.Checked++
+		prevHash = entry.Hash
+		expected++
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
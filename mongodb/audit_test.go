@@ -0,0 +1,86 @@
+package mongoDb
+
+import (
+	"testing"
+)
+
+func testAuditChain() []auditEntryDoc {
+	entries := []auditEntryDoc{
+		{Id: "a-1", ActorId: "patient-1", ActorRole: "patient", Action: auditCreate, ResourceType: "medical_records", ResourceId: "m-1", UserId: "patient-1", Timestamp: "2026-03-09T10:00:00.000000001Z", Metadata: map[string]string{"method": "/health.HealthAnalyticsService/AddMedicalRecord"}},
+		{Id: "a-2", ActorId: "doctor-1", ActorRole: "doctor", Action: auditGet, ResourceType: "medical_records", ResourceId: "m-1", UserId: "patient-1", Timestamp: "2026-03-09T10:05:00Z", Metadata: map[string]string{"peer": "10.0.0.1:5000", "user-agent": "grpc-go"}},
+		{Id: "a-3", ActorId: "patient-1", ActorRole: "patient", Action: auditDelete, ResourceType: "medical_records", ResourceId: "m-1", UserId: "patient-1", Timestamp: "2026-03-09T11:00:00Z"},
+	}
+	var prev auditEntryDoc
+	for i := range entries {
+		entries[i] = entries[i].chainedTo(prev)
+		prev = entries[i]
+	}
+	return entries
+}
+
+// verifyChain VerifyAuditLog dagi kabi birinchi buzilgan seq ni qaytaradi, 0 bo'lsa zanjir butun
+func verifyChain(entries []auditEntryDoc) int64 {
+	expected, prevHash := int64(1), ""
+	for _, e := range entries {
+		if !e.follows(expected, prevHash) {
+			return expected
+		}
+		prevHash = e.Hash
+		expected++
+	}
+	return 0
+}
+
+func TestAuditChain(t *testing.T) {
+	chain := testAuditChain()
+	for i, e := range chain {
+		if e.Seq != int64(i+1) {
+			t.Errorf("entry %d seq = %d", i, e.Seq)
+		}
+	}
+	if chain[0].PrevHash != "" {
+		t.Errorf("first entry prev_hash = %q, want empty", chain[0].PrevHash)
+	}
+
+	tests := []struct {
+		name   string
+		tamper func([]auditEntryDoc) []auditEntryDoc
+		want   int64
+	}{
+		{"intact", func(c []auditEntryDoc) []auditEntryDoc { return c }, 0},
+		{"changed action", func(c []auditEntryDoc) []auditEntryDoc { c[1].Action = auditList; return c }, 2},
+		{"changed actor", func(c []auditEntryDoc) []auditEntryDoc { c[0].ActorId = "admin-1"; return c }, 1},
+		{"changed timestamp", func(c []auditEntryDoc) []auditEntryDoc { c[0].Timestamp = "2026-03-09T10:00:00Z"; return c }, 1},
+		{"changed metadata", func(c []auditEntryDoc) []auditEntryDoc { c[1].Metadata["peer"] = "10.0.0.2:5000"; return c }, 2},
+		{"rehashed entry", func(c []auditEntryDoc) []auditEntryDoc {
+			c[1].UserId = "patient-2"
+			c[1].Hash = c[1].computeHash()
+			return c
+		}, 3},
+		{"removed entry", func(c []auditEntryDoc) []auditEntryDoc { return append(c[:1], c[2:]...) }, 2},
+		{"swapped entries", func(c []auditEntryDoc) []auditEntryDoc { c[1], c[2] = c[2], c[1]; return c }, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := verifyChain(tt.tamper(testAuditChain())); got != tt.want {
+				t.Errorf("first invalid seq = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuditHashIgnoresMapOrder(t *testing.T) {
+	a := auditEntryDoc{Id: "a-1", Metadata: map[string]string{"method": "m", "peer": "p", "user-agent": "u"}}
+	b := auditEntryDoc{Id: "a-1", Metadata: map[string]string{}}
+	for _, k := range []string{"user-agent", "peer", "method"} {
+		b.Metadata[k] = a.Metadata[k]
+	}
+	if a.computeHash() != b.computeHash() {
+		t.Error("hash depends on metadata insertion order")
+	}
+
+	a.Hash = "something"
+	if a.computeHash() != b.computeHash() {
+		t.Error("hash depends on the hash field itself")
+	}
+}
@@ -0,0 +1,53 @@
+package mongoDb
+
+import (
+	"context"
+
+	"health/pkg/auth"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authorizeOwner faqat id bilan keladigan so'rovlar uchun hujjat egasini topib
+// so'rov egasiga ruxsatni tekshiradi va egasini qaytaradi. Hujjat topilmasa
+// yoki unga ruxsat bo'lmasa notFound xabari bilan NotFound qaytadi, shunda metod
+// bo'sh ega uchun hech narsa (masalan shifrlash kaliti) yaratmaydi.
+func (h *Health) authorizeOwner(ctx context.Context, collection, id, notFound string) (string, error) {
+	var doc struct {
+		UserId string `bson:"user_id"`
+	}
+	err := h.Db.Collection(collection).FindOne(ctx, bson.M{"id": id},
+		options.FindOne().SetProjection(bson.M{"user_id": 1}),
+	).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return "", status.Error(codes.NotFound, notFound)
+	}
+	if err != nil {
+		return "", err
+	}
+	return doc.UserId, authorizeRead(ctx, doc.UserId, notFound)
+}
+
+// authorizeRead so'rov egasiga owner hujjatiga ruxsatni tekshiradi. Ruxsat
+// bo'lmasa ham notFound xabari bilan NotFound qaytadi: boshqa foydalanuvchining
+// yozuvi borligi oshkor bo'lmaydi.
+func authorizeRead(ctx context.Context, owner, notFound string) error {
+	err := auth.Authorize(ctx, owner)
+	if status.Code(err) == codes.PermissionDenied {
+		return status.Error(codes.NotFound, notFound)
+	}
+	return err
+}
+
+// callerFilter user_id siz ro'yxat so'rovlarini so'rov egasining o'z hujjatlari
+// bilan cheklaydi. Faqat admin uchun filter o'zgarmaydi.
+func callerFilter(ctx context.Context, filter bson.M) bson.M {
+	if p, ok := auth.FromContext(ctx); ok && !p.IsAdmin() {
+		filter["user_id"] = p.UserID
+	}
+	return filter
+}
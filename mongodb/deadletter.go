@@ -0,0 +1,506 @@
+package mongoDb
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"health/config"
+	pb "health/genproto/health_analytics"
+	"health/pkg/auth"
+	"health/pkg/connection"
+
+	"github.com/google/uuid"
+	"github.com/streadway/amqp"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxDeliveryRetries vaqtinchalik xatolikda qayta urinishlar soni, undan
+	// keyin xabar dead-letter ga o'tadi
+	maxDeliveryRetries = 5
+	retryBaseDelay     = time.Second
+	retryMaxDelay      = 5 * time.Minute
+
+	headerRetryCount    = "x-retry-count"
+	headerOriginalQueue = "x-original-queue"
+	headerDeadReason    = "x-dead-letter-reason"
+	headerDeadAt        = "x-dead-lettered-at"
+)
+
+// retryDelay n-urinishdan oldingi kutish: 1s, 2s, 4s, ... retryMaxDelay gacha
+func retryDelay(attempt int) time.Duration {
+	d := retryBaseDelay << attempt
+	if d <= 0 || d > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return d
+}
+
+// permanentError qayta urinish foyda bermaydigan xatolik, masalan buzilgan
+// JSON. Bunday xabar darhol dead-letter ga yuboriladi.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+func permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// deliveryHandler bitta xabarni qayta ishlaydi
+type deliveryHandler func(context.Context, amqp.Delivery) error
+
+// batchHandler xabarlar to'plamini qayta ishlaydi va har bir xabar natijasini
+// shu tartibda qaytaradi: nil muvaffaqiyat, xatolik esa deliveryHandler dagi kabi
+// retry yoki dead-letter ga olib boradi
+type batchHandler func(context.Context, []amqp.Delivery) []error
+
+// settleFunc qayta ishlangan xabarni natijasiga qarab ack yoki nack qiladi
+type settleFunc func(ctx context.Context, queue string, msg amqp.Delivery, err error)
+
+// oneByOne xabarlarni birma-bir qayta ishlaydigan batchHandler
+func oneByOne(handle deliveryHandler) batchHandler {
+	return func(ctx context.Context, msgs []amqp.Delivery) []error {
+		errs := make([]error, len(msgs))
+		for i, msg := range msgs {
+			errs[i] = handle(ctx, msg)
+		}
+		return errs
+	}
+}
+
+// consumeQueue navbatdagi xabarlarni handle bilan qayta ishlaydi. Xabar faqat
+// handle uni muvaffaqiyatli qayta ishlagandan keyin ack qilinadi. Vaqtinchalik
+// xatolikda xabar kechikish bilan retry navbatiga, urinishlar tugaganda yoki
+// permanentError da dead-letter ga yuboriladi. Ularni yuborib bo'lmasa xabar
+// navbatga qaytariladi. q.BatchSize 1 dan katta bo'lsa xabarlar BatchSize tagacha
+// yoki BatchWait o'tguncha yig'ilib handle ga birga beriladi.
+// Navbat q.Concurrency ta consumer bilan o'qiladi, metod hammasi to'xtaguncha qaytmaydi.
+func (h *Health) consumeQueue(ctx context.Context, q config.QueueConfig, handle batchHandler) {
+	h.consumeWith(ctx, q, handle, h.settle)
+}
+
+// consumeWith consumeQueue kabi, lekin natijani settle hal qiladi
+func (h *Health) consumeWith(ctx context.Context, q config.QueueConfig, handle batchHandler, settle settleFunc) {
+	var wg sync.WaitGroup
+	for i := 0; i < max(q.Concurrency, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h.consumeLoop(ctx, q, handle, settle)
+		}()
+	}
+	wg.Wait()
+}
+
+func (h *Health) consumeLoop(ctx context.Context, q config.QueueConfig, handle batchHandler, settle settleFunc) {
+	queue := q.Name
+	for attempt := 0; ctx.Err() == nil; attempt++ {
+		// Kanal yopilsa (broker qayta ishga tushsa) ulanish tiklanguncha kutib
+		// qaytadan obuna bo'lamiz
+		delivered, err := h.consumeOnce(ctx, q, handle, settle)
+		if err != nil {
+			h.Logger.Error("Failed to register a consumer", "queue", queue, "error", err)
+		} else {
+			h.Logger.Warn("Consumer channel closed, resubscribing", "queue", queue)
+		}
+		if delivered {
+			attempt = 0
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(connection.DefaultBackoff.Delay(attempt)):
+		}
+	}
+}
+
+// consumeOnce alohida kanal ochib navbatni kanal yopilguncha o'qiydi. Kamida
+// bitta xabar olingan bo'lsa true qaytadi. Yig'ilib qayta ishlanmagan xabarlar
+// ack qilinmagan, kanal yopilganda broker ularni navbatga qaytaradi.
+func (h *Health) consumeOnce(ctx context.Context, q config.QueueConfig, handle batchHandler, settle settleFunc) (bool, error) {
+	ch, err := h.MQ.OpenChannel(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer ch.Close()
+
+	// Prefetch batch dan kichik bo'lsa batch hech qachon to'lmaydi
+	size := max(q.BatchSize, 1)
+	if err := ch.Qos(max(q.Prefetch, size), 0, false); err != nil {
+		return false, err
+	}
+	messages, err := ch.Consume(
+		q.Name, // Queue nomi
+		"",     // Consumer tag
+		false,  // Auto-ack
+		false,  // Exclusive
+		false,  // No-local
+		false,  // No-wait
+		nil,    // Arguments
+	)
+	if err != nil {
+		return false, err
+	}
+
+	delivered := false
+	batch := make([]amqp.Delivery, 0, size)
+	var timer *time.Timer
+	var flush <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return delivered, nil
+		case msg, ok := <-messages:
+			if !ok {
+				return delivered, nil
+			}
+			delivered = true
+			batch = append(batch, msg)
+			if len(batch) < size {
+				if len(batch) == 1 {
+					timer = time.NewTimer(q.BatchWait)
+					flush = timer.C
+				}
+				continue
+			}
+		case <-flush:
+		}
+
+		if timer != nil {
+			timer.Stop()
+			timer, flush = nil, nil
+		}
+		h.handleBatch(ctx, q.Name, batch, handle, settle)
+		batch = batch[:0]
+	}
+}
+
+// handleBatch xabarlarni qayta ishlaydi va har birini settle ga beradi
+func (h *Health) handleBatch(ctx context.Context, queue string, msgs []amqp.Delivery, handle batchHandler, settle settleFunc) {
+	errs := handle(ctx, msgs)
+	for i, msg := range msgs {
+		settle(ctx, queue, msg, errs[i])
+	}
+}
+
+// settle bitta xabarni qayta ishlash natijasiga qarab ack, retry yoki dead-letter qiladi
+func (h *Health) settle(ctx context.Context, queue string, msg amqp.Delivery, err error) {
+	if err == nil {
+		h.ack(queue, msg)
+		return
+	}
+
+	retries := retryCount(msg)
+	var perm permanentError
+	switch {
+	case errors.As(err, &perm):
+		h.Logger.Error("Dropping unprocessable message to dead-letter queue", "queue", queue, "message_id", msg.MessageId, "error", err)
+		err = h.deadLetter(ctx, queue, msg, retries, err.Error())
+	case retries >= maxDeliveryRetries:
+		h.Logger.Error("Message retries exhausted, moving to dead-letter queue", "queue", queue, "message_id", msg.MessageId, "retries", retries, "error", err)
+		err = h.deadLetter(ctx, queue, msg, retries, err.Error())
+	default:
+		h.Logger.Warn("Failed to process message, scheduling retry", "queue", queue, "message_id", msg.MessageId, "retry", retries+1, "error", err)
+		err = h.retry(ctx, queue, msg, retries+1)
+	}
+
+	if err != nil {
+		h.Logger.Error("Failed to reroute message, requeueing", "queue", queue, "message_id", msg.MessageId, "error", err)
+		if err := msg.Nack(false, true); err != nil {
+			h.Logger.Error("Failed to nack message", "queue", queue, "error", err)
+		}
+		return
+	}
+	h.ack(queue, msg)
+}
+
+func (h *Health) ack(queue string, msg amqp.Delivery) {
+	if err := msg.Ack(false); err != nil {
+		h.Logger.Error("Failed to ack message", "queue", queue, "message_id", msg.MessageId, "error", err)
+	}
+}
+
+func retryCount(msg amqp.Delivery) int {
+	switch v := msg.Headers[headerRetryCount].(type) {
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	}
+	return 0
+}
+
+// forward xabarni sarlavhalari bilan boshqa joyga qayta yuborish uchun nusxalaydi
+func forward(msg amqp.Delivery, headers amqp.Table) amqp.Publishing {
+	merged := amqp.Table{}
+	for k, v := range msg.Headers {
+		merged[k] = v
+	}
+	for k, v := range headers {
+		merged[k] = v
+	}
+
+	messageID := msg.MessageId
+	if messageID == "" {
+		messageID = uuid.NewString()
+	}
+	return amqp.Publishing{
+		Headers:      merged,
+		ContentType:  msg.ContentType,
+		DeliveryMode: amqp.Persistent,
+		MessageId:    messageID,
+		Timestamp:    msg.Timestamp,
+		Body:         msg.Body,
+	}
+}
+
+// retry xabarni kechikish bilan retry navbatiga yuboradi. Kechikish xabar
+// expiration i orqali beriladi: muddati o'tgach broker uni asl navbatga qaytaradi.
+// Retry navbatida muddat faqat navbat boshida tekshiriladi, shuning uchun qisqa
+// kechikishli xabar uzunroq kechikishli xabar ortida retryMaxDelay gacha kutishi mumkin.
+func (h *Health) retry(ctx context.Context, queue string, msg amqp.Delivery, attempt int) error {
+	pub := forward(msg, amqp.Table{headerRetryCount: int32(attempt)})
+	pub.Expiration = strconv.FormatInt(retryDelay(attempt-1).Milliseconds(), 10)
+	return h.publishMessage(ctx, "", retryQueue(queue), pub)
+}
+
+func (h *Health) deadLetter(ctx context.Context, queue string, msg amqp.Delivery, retries int, reason string) error {
+	return h.publishMessage(ctx, h.Topology.DeadLetterExchange, queue, forward(msg, amqp.Table{
+		headerRetryCount:    int32(retries),
+		headerOriginalQueue: queue,
+		headerDeadReason:    reason,
+		headerDeadAt:        time.Now().Format(time.RFC3339),
+	}))
+}
+
+// deadLetterDoc dead_letters kolleksiyasidagi hujjat. "<queue>.dlq" dagi
+// xabarlar shu yerga ko'chiriladi: navbatni ko'rish uchun undan xabar olib
+// qaytarish shart emas va kerakli xabarni id bo'yicha qayta yuborish mumkin.
+type deadLetterDoc struct {
+	Id             string    `bson:"id"`
+	Queue          string    `bson:"queue"`
+	MessageId      string    `bson:"message_id"`
+	Reason         string    `bson:"reason"`
+	Retries        int32     `bson:"retries"`
+	DeadLetteredAt time.Time `bson:"dead_lettered_at"`
+	ContentType    string    `bson:"content_type"`
+	// Headers dead-letter va retry sarlavhalarisiz, faqat oddiy qiymatlar
+	Headers   bson.M    `bson:"headers"`
+	Timestamp time.Time `bson:"timestamp"`
+	Body      []byte    `bson:"body"`
+}
+
+func (d deadLetterDoc) toProto() *pb.DeadLetter {
+	return &pb.DeadLetter{
+		MessageId:      d.MessageId,
+		Queue:          d.Queue,
+		Reason:         d.Reason,
+		Retries:        d.Retries,
+		DeadLetteredAt: protoTime(d.DeadLetteredAt),
+		Body:           string(d.Body),
+	}
+}
+
+// deadLetterHeaders xabar replay qilinganda olib tashlanadigan sarlavhalar
+var deadLetterHeaders = []string{headerRetryCount, headerOriginalQueue, headerDeadReason, headerDeadAt, "x-death"}
+
+// newDeadLetterDoc "<queue>.dlq" dan olingan xabardan hujjat tuzadi
+func newDeadLetterDoc(queue string, msg amqp.Delivery) deadLetterDoc {
+	reason, _ := msg.Headers[headerDeadReason].(string)
+	var deadAt time.Time
+	if value, ok := msg.Headers[headerDeadAt].(string); ok {
+		deadAt, _ = time.Parse(time.RFC3339, value)
+	}
+
+	// Broker o'zi dead-letter qilgan xabarlarda (masalan TTL tugaganda) sabab
+	// x-death sarlavhasida bo'ladi
+	if deaths, ok := msg.Headers["x-death"].([]interface{}); ok && reason == "" && len(deaths) > 0 {
+		if death, ok := deaths[0].(amqp.Table); ok {
+			reason, _ = death["reason"].(string)
+			if t, ok := death["time"].(time.Time); ok {
+				deadAt = t
+			}
+		}
+	}
+	if deadAt.IsZero() {
+		deadAt = now()
+	}
+
+	headers := bson.M{}
+	for k, v := range msg.Headers {
+		if slices.Contains(deadLetterHeaders, k) {
+			continue
+		}
+		switch v.(type) {
+		case string, bool, int32, int64, float64:
+			headers[k] = v
+		}
+	}
+
+	messageID := msg.MessageId
+	if messageID == "" {
+		messageID = uuid.NewString()
+	}
+	return deadLetterDoc{
+		Id:             uuid.NewString(),
+		Queue:          queue,
+		MessageId:      messageID,
+		Reason:         reason,
+		Retries:        int32(retryCount(msg)),
+		DeadLetteredAt: deadAt.UTC().Truncate(time.Millisecond),
+		ContentType:    msg.ContentType,
+		Headers:        headers,
+		Timestamp:      msg.Timestamp.UTC().Truncate(time.Millisecond),
+		Body:           msg.Body,
+	}
+}
+
+// ConsumeDeadLetterQueues har bir o'qiladigan navbatning "<queue>.dlq" idagi
+// xabarlarni dead_letters kolleksiyasiga ko'chiradi. Bir xabar ikki marta
+// yetkazilsa ham (message_id, dead_lettered_at) bo'yicha bitta hujjat bo'ladi.
+func (h *Health) ConsumeDeadLetterQueues(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, q := range h.consumedQueues() {
+		wg.Add(1)
+		go func(queue string) {
+			defer wg.Done()
+			dlq := config.QueueConfig{Name: deadLetterQueue(queue), Prefetch: 10, Concurrency: 1}
+			h.consumeWith(ctx, dlq, oneByOne(func(ctx context.Context, msg amqp.Delivery) error {
+				return h.archiveDeadLetter(ctx, queue, msg)
+			}), h.settleArchived)
+		}(q.Name)
+	}
+	wg.Wait()
+}
+
+func (h *Health) archiveDeadLetter(ctx context.Context, queue string, msg amqp.Delivery) error {
+	doc := newDeadLetterDoc(queue, msg)
+	_, err := h.Db.Collection("dead_letters").UpdateOne(ctx,
+		bson.M{"queue": doc.Queue, "message_id": doc.MessageId, "dead_lettered_at": doc.DeadLetteredAt},
+		bson.M{"$setOnInsert": doc},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// settleArchived dead-letter navbatidagi xabarni saqlangach ack qiladi. Saqlab
+// bo'lmasa xabar retryBaseDelay dan keyin navbatga qaytariladi: dead-letter
+// navbatining o'zi uchun retry yoki dead-letter navbati yo'q.
+func (h *Health) settleArchived(ctx context.Context, queue string, msg amqp.Delivery, err error) {
+	if err == nil {
+		h.ack(queue, msg)
+		return
+	}
+
+	h.Logger.Error("Failed to archive dead letter, requeueing", "queue", queue, "message_id", msg.MessageId, "error", err)
+	select {
+	case <-ctx.Done():
+	case <-time.After(retryBaseDelay):
+	}
+	if err := msg.Nack(false, true); err != nil {
+		h.Logger.Error("Failed to nack message", "queue", queue, "error", err)
+	}
+}
+
+// ListDeadLetters navbatning saqlangan dead-letter xabarlarini eskisidan
+// boshlab ko'rsatadi. Faqat admin uchun.
+func (h *Health) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	if err := auth.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := h.validateConsumedQueue(req.Queue); err != nil {
+		return nil, err
+	}
+
+	docs, err := h.findDeadLetters(ctx, bson.M{"queue": req.Queue}, pageSize(req.Limit))
+	if err != nil {
+		h.Logger.Error("Failed to list dead letters", "queue", req.Queue, "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListDeadLettersResponse{}
+	for _, d := range docs {
+		resp.DeadLetters = append(resp.DeadLetters, d.toProto())
+	}
+	return resp, nil
+}
+
+// findDeadLetters filtrga mos xabarlar, eskisidan boshlab. limit 0 bo'lsa hammasi.
+func (h *Health) findDeadLetters(ctx context.Context, filter bson.M, limit int64) ([]deadLetterDoc, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "dead_lettered_at", Value: 1}, {Key: "id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	cursor, err := h.Db.Collection("dead_letters").Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var docs []deadLetterDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// ReplayDeadLetters saqlangan dead-letter xabarlarni urinishlar hisobini nollab
+// asl navbatga qaytaradi va kolleksiyadan o'chiradi. message_ids bo'sh bo'lsa
+// limit tagacha (0 bo'lsa hammasi) eskisidan boshlab qaytariladi.
+func (h *Health) ReplayDeadLetters(ctx context.Context, req *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	if err := auth.RequireRole(ctx, auth.RoleAdmin); err != nil {
+		return nil, err
+	}
+	if err := h.validateConsumedQueue(req.Queue); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"queue": req.Queue}
+	limit := req.Limit
+	if len(req.MessageIds) > 0 {
+		filter["message_id"] = bson.M{"$in": req.MessageIds}
+		limit = 0
+	}
+	docs, err := h.findDeadLetters(ctx, filter, limit)
+	if err != nil {
+		h.Logger.Error("Failed to read dead letters", "queue", req.Queue, "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ReplayDeadLettersResponse{}
+	for _, d := range docs {
+		headers := amqp.Table{}
+		for k, v := range d.Headers {
+			headers[k] = v
+		}
+		err := h.publishMessage(ctx, "", req.Queue, amqp.Publishing{
+			Headers:      headers,
+			ContentType:  d.ContentType,
+			DeliveryMode: amqp.Persistent,
+			MessageId:    d.MessageId,
+			Timestamp:    d.Timestamp,
+			Body:         d.Body,
+		})
+		if err != nil {
+			h.Logger.Error("Failed to replay dead letter", "queue", req.Queue, "message_id", d.MessageId, "error", err)
+			return nil, status.Errorf(codes.Unavailable, "xabar navbatga qaytarilmadi: %v", err)
+		}
+		// Xabar navbatga tushgan, o'chirib bo'lmasa keyingi replay uni takror yuboradi
+		if _, err := h.Db.Collection("dead_letters").DeleteOne(ctx, bson.M{"id": d.Id}); err != nil {
+			h.Logger.Error("Failed to delete replayed dead letter", "queue", req.Queue, "message_id", d.MessageId, "error", err)
+			return nil, err
+		}
+		resp.Replayed++
+	}
+
+	h.Logger.Info("Replayed dead letters", "queue", req.Queue, "count", resp.Replayed)
+	return resp, nil
+}
@@ -0,0 +1,338 @@
+package mongoDb
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	pb "health/genproto/health_analytics"
+	"health/pkg/auth"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// doctorConsentDoc doctor_consents kolleksiyasidagi hujjat. Bekor qilingan
+// rozilik o'chirilmaydi, revoked_at ga vaqt yoziladi. Faol rozilikda revoked_at null.
+type doctorConsentDoc struct {
+	Id        string     `bson:"id"`
+	PatientId string     `bson:"patient_id"`
+	DoctorId  string     `bson:"doctor_id"`
+	CreatedAt time.Time  `bson:"created_at"`
+	RevokedAt *time.Time `bson:"revoked_at"`
+}
+
+func (d doctorConsentDoc) toProto() *pb.DoctorConsent {
+	return &pb.DoctorConsent{
+		Id:        d.Id,
+		PatientId: d.PatientId,
+		DoctorId:  d.DoctorId,
+		CreatedAt: protoTime(d.CreatedAt),
+	}
+}
+
+// HasConsent auth.ConsentChecker ni amalga oshiradi
+func (h *Health) HasConsent(ctx context.Context, patientID, doctorID string) (bool, error) {
+	n, err := h.Db.Collection("doctor_consents").CountDocuments(ctx,
+		bson.M{"patient_id": patientID, "doctor_id": doctorID, "revoked_at": nil},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// consentedPatients shifokorga rozilik bergan bemorlar id lari
+func (h *Health) consentedPatients(ctx context.Context, doctorID string) ([]string, error) {
+	ids, err := h.Db.Collection("doctor_consents").Distinct(ctx, "patient_id",
+		bson.M{"doctor_id": doctorID, "revoked_at": nil},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	patients := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if s, ok := id.(string); ok {
+			patients = append(patients, s)
+		}
+	}
+	return patients, nil
+}
+
+// GrantDoctorConsent bemor shifokorga o'z yozuvlarini ko'rishga ruxsat beradi.
+// Faol rozilik allaqachon bo'lsa o'sha qaytariladi.
+func (h *Health) GrantDoctorConsent(ctx context.Context, req *pb.GrantDoctorConsentRequest) (*pb.GrantDoctorConsentResponse, error) {
+	if req.PatientId == "" || req.DoctorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "patient_id va doctor_id kiritilishi shart")
+	}
+	if err := auth.AuthorizeSelf(ctx, req.PatientId); err != nil {
+		return nil, err
+	}
+
+	consent := doctorConsentDoc{
+		Id:        uuid.NewString(),
+		PatientId: req.PatientId,
+		DoctorId:  req.DoctorId,
+		CreatedAt: now(),
+	}
+
+	err := h.Db.Collection("doctor_consents").FindOneAndUpdate(ctx,
+		bson.M{"patient_id": req.PatientId, "doctor_id": req.DoctorId, "revoked_at": nil},
+		bson.M{"$setOnInsert": consent},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&consent)
+	if err != nil {
+		h.Logger.Error("Failed to grant doctor consent", "error", err)
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditCreate, "doctor_consents", consent.Id, req.PatientId); err != nil {
+		return nil, err
+	}
+
+	return &pb.GrantDoctorConsentResponse{Consent: consent.toProto()}, nil
+}
+
+// RevokeDoctorConsent rozilikni bekor qiladi. Buni bemor yoki shifokorning o'zi qila oladi.
+func (h *Health) RevokeDoctorConsent(ctx context.Context, req *pb.RevokeDoctorConsentRequest) (*pb.RevokeDoctorConsentResponse, error) {
+	if auth.AuthorizeSelf(ctx, req.PatientId) != nil {
+		if err := auth.AuthorizeSelf(ctx, req.DoctorId); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := h.Db.Collection("doctor_consents").UpdateMany(ctx,
+		bson.M{"patient_id": req.PatientId, "doctor_id": req.DoctorId, "revoked_at": nil},
+		bson.M{"$set": bson.M{"revoked_at": now()}},
+	)
+	if err != nil {
+		h.Logger.Error("Failed to revoke doctor consent", "error", err)
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		h.Logger.Warn("Doctor consent not found for revoke", "patient_id", req.PatientId, "doctor_id", req.DoctorId)
+		return &pb.RevokeDoctorConsentResponse{Success: false}, errors.New("rozilik topilmadi")
+	}
+
+	if err := h.audit(ctx, auditDelete, "doctor_consents", "", req.PatientId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeDoctorConsentResponse{Success: true}, nil
+}
+
+// ListDoctorConsents bemorning faol roziliklari
+func (h *Health) ListDoctorConsents(ctx context.Context, req *pb.ListDoctorConsentsRequest) (*pb.ListDoctorConsentsResponse, error) {
+	if err := auth.AuthorizeSelf(ctx, req.PatientId); err != nil {
+		return nil, err
+	}
+
+	cursor, err := h.Db.Collection("doctor_consents").Find(ctx,
+		bson.M{"patient_id": req.PatientId, "revoked_at": nil},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		h.Logger.Error("Failed to list doctor consents", "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []doctorConsentDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		h.Logger.Error("Failed to decode doctor consents", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListDoctorConsentsResponse{}
+	for _, d := range docs {
+		resp.Consents = append(resp.Consents, d.toProto())
+	}
+
+	if err := h.audit(ctx, auditList, "doctor_consents", "", req.PatientId); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type doctorListCursor struct {
+	CreatedAt time.Time `json:"c"`
+	Id        string    `json:"i"`
+}
+
+// ListPatientsForDoctor shifokorga rozilik bergan bemorlar, oxirgi rozilik
+// berganlaridan boshlab
+func (h *Health) ListPatientsForDoctor(ctx context.Context, req *pb.ListPatientsForDoctorRequest) (*pb.ListPatientsForDoctorResponse, error) {
+	if err := auth.AuthorizeSelf(ctx, req.DoctorId); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"doctor_id": req.DoctorId, "revoked_at": nil}
+	if req.Cursor != "" {
+		var c doctorListCursor
+		if err := decodeCursor(req.Cursor, &c); err != nil {
+			return nil, err
+		}
+		filter["$or"] = []bson.M{
+			{"created_at": bson.M{"$lt": c.CreatedAt}},
+			{"created_at": c.CreatedAt, "id": bson.M{"$lt": c.Id}},
+		}
+	}
+
+	limit := pageSize(req.Limit)
+	cursor, err := h.Db.Collection("doctor_consents").Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "id", Value: -1}}).
+			SetLimit(limit+1),
+	)
+	if err != nil {
+		h.Logger.Error("Failed to list patients for doctor", "doctor_id", req.DoctorId, "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []doctorConsentDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		h.Logger.Error("Failed to decode doctor consents", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListPatientsForDoctorResponse{}
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		last := docs[len(docs)-1]
+		resp.NextCursor, err = encodeCursor(doctorListCursor{CreatedAt: last.CreatedAt, Id: last.Id})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range docs {
+		firstName, lastName := h.userName(ctx, d.PatientId)
+		resp.Patients = append(resp.Patients, &pb.DoctorPatient{
+			PatientId:   d.PatientId,
+			FirstName:   firstName,
+			LastName:    lastName,
+			ConsentedAt: protoTime(d.CreatedAt),
+		})
+	}
+
+	if err := h.audit(ctx, auditList, "doctor_consents", "", req.DoctorId); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ListMedicalRecordsByDoctor shifokor doctor_id sifatida ko'rsatilgan tibbiy
+// yozuvlar. Rozilik bermagan yoki uni bekor qilgan bemorlarning yozuvlari chiqmaydi.
+func (h *Health) ListMedicalRecordsByDoctor(ctx context.Context, req *pb.ListMedicalRecordsByDoctorRequest) (*pb.ListMedicalRecordsByDoctorResponse, error) {
+	if err := auth.AuthorizeSelf(ctx, req.DoctorId); err != nil {
+		return nil, err
+	}
+
+	patients, err := h.consentedPatients(ctx, req.DoctorId)
+	if err != nil {
+		h.Logger.Error("Failed to get consented patients", "doctor_id", req.DoctorId, "error", err)
+		return nil, err
+	}
+	if req.PatientId != "" {
+		allowed := false
+		for _, id := range patients {
+			if id == req.PatientId {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, status.Error(codes.PermissionDenied, "bemor bu shifokorga rozilik bermagan")
+		}
+		patients = []string{req.PatientId}
+	}
+	if len(patients) == 0 {
+		return &pb.ListMedicalRecordsByDoctorResponse{}, nil
+	}
+
+	filter := bson.M{
+		"doctor_id":  req.DoctorId,
+		"user_id":    bson.M{"$in": patients},
+		"deleted_at": nil,
+	}
+	if req.Cursor != "" {
+		var c doctorListCursor
+		if err := decodeCursor(req.Cursor, &c); err != nil {
+			return nil, err
+		}
+		filter["$or"] = []bson.M{
+			{"created_at": bson.M{"$lt": c.CreatedAt}},
+			{"created_at": c.CreatedAt, "id": bson.M{"$lt": c.Id}},
+		}
+	}
+
+	limit := pageSize(req.Limit)
+	cursor, err := h.Db.Collection("medical_records").Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "id", Value: -1}}).
+			SetLimit(limit+1),
+	)
+	if err != nil {
+		h.Logger.Error("Failed to list medical records by doctor", "doctor_id", req.DoctorId, "error", err)
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []medicalRecordDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		h.Logger.Error("Failed to decode medical records", "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListMedicalRecordsByDoctorResponse{}
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		last := docs[len(docs)-1]
+		resp.NextCursor, err = encodeCursor(doctorListCursor{CreatedAt: last.CreatedAt, Id: last.Id})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, d := range docs {
+		if err := h.decryptRecord(ctx, &d); err != nil {
+			return nil, err
+		}
+		resp.MedicalRecords = append(resp.MedicalRecords, d.toProto())
+	}
+
+	if err := h.audit(ctx, auditList, "medical_records", "", req.PatientId); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// createConsentIndexes bitta bemor-shifokor juftligi uchun faqat bitta faol
+// rozilik bo'lishini ta'minlaydi, shunda parallel GrantDoctorConsent ikkita
+// hujjat yarata olmaydi
+func createConsentIndexes(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	names, err := db.Collection("doctor_consents").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "patient_id", Value: 1}, {Key: "doctor_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"revoked_at": "0"}),
+		},
+		{Keys: bson.D{{Key: "doctor_id", Value: 1}, {Key: "revoked_at", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("Created doctor consent indexes", "indexes", names)
+	return nil
+}
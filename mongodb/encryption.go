@@ -0,0 +1,317 @@
+package mongoDb
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"health/pkg/envelope"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// encryptedPrefix shifrlangan maydon qiymati "enc:<data key versiyasi>:<base64>"
+// ko'rinishida saqlanadi. Prefikssiz qiymat shifrlashdan oldingi ochiq matn.
+const encryptedPrefix = "enc:"
+
+// dataKeyDoc data_keys kolleksiyasidagi foydalanuvchi data key i. Kalitning
+// o'zi faqat master key bilan o'ralgan holda saqlanadi.
+type dataKeyDoc struct {
+	UserId      string    `bson:"user_id"`
+	Version     int       `bson:"version"`
+	MasterKeyId string    `bson:"master_key_id"`
+	WrappedKey  []byte    `bson:"wrapped_key"`
+	CreatedAt   time.Time `bson:"created_at"`
+}
+
+func dataKeyCacheKey(userID string, version int) string {
+	return userID + "/" + strconv.Itoa(version)
+}
+
+// unwrapDataKey o'ralgan kalitni ochadi va keshga qo'yadi
+func (h *Health) unwrapDataKey(doc dataKeyDoc) ([]byte, error) {
+	key, err := h.Keys.Unwrap(doc.MasterKeyId, doc.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("data key ochilmadi (user_id=%s, version=%d): %v", doc.UserId, doc.Version, err)
+	}
+	h.dataKeys.Store(dataKeyCacheKey(doc.UserId, doc.Version), key)
+	return key, nil
+}
+
+// dataKey foydalanuvchining berilgan versiyadagi data key i
+func (h *Health) dataKey(ctx context.Context, userID string, version int) ([]byte, error) {
+	if key, ok := h.dataKeys.Load(dataKeyCacheKey(userID, version)); ok {
+		return key.([]byte), nil
+	}
+
+	var doc dataKeyDoc
+	err := h.Db.Collection("data_keys").FindOne(ctx, bson.M{"user_id": userID, "version": version}).Decode(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("data key topilmadi (user_id=%s, version=%d): %v", userID, version, err)
+	}
+	return h.unwrapDataKey(doc)
+}
+
+// currentDataKey foydalanuvchining eng yangi data key i. Kalit hali bo'lmasa
+// yaratiladi.
+func (h *Health) currentDataKey(ctx context.Context, userID string) (int, []byte, error) {
+	var doc dataKeyDoc
+	err := h.Db.Collection("data_keys").FindOne(ctx, bson.M{"user_id": userID},
+		options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}}),
+	).Decode(&doc)
+	if err == nil {
+		key, err := h.dataKey(ctx, userID, doc.Version)
+		return doc.Version, key, err
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil, err
+	}
+	return h.newDataKey(ctx, userID, 1)
+}
+
+// newDataKey yangi versiyadagi data key yaratadi. Boshqa so'rov shu versiyani
+// oldinroq yaratgan bo'lsa o'sha kalit ishlatiladi.
+func (h *Health) newDataKey(ctx context.Context, userID string, version int) (int, []byte, error) {
+	key, err := envelope.NewDataKey()
+	if err != nil {
+		return 0, nil, err
+	}
+	masterKeyID, wrapped, err := h.Keys.Wrap(key)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	_, err = h.Db.Collection("data_keys").InsertOne(ctx, dataKeyDoc{
+		UserId:      userID,
+		Version:     version,
+		MasterKeyId: masterKeyID,
+		WrappedKey:  wrapped,
+		CreatedAt:   now(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		key, err := h.dataKey(ctx, userID, version)
+		return version, key, err
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	h.dataKeys.Store(dataKeyCacheKey(userID, version), key)
+	return version, key, nil
+}
+
+func fieldAAD(userID, field string) []byte {
+	return []byte("medical_records/" + userID + "/" + field)
+}
+
+func sealField(key []byte, version int, userID, field, value string) (string, error) {
+	sealed, err := envelope.Seal(key, []byte(value), fieldAAD(userID, field))
+	if err != nil {
+		return "", err
+	}
+	return encryptedPrefix + strconv.Itoa(version) + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// encryptField tibbiy yozuv maydonini foydalanuvchi data key i bilan
+// shifrlaydi. Master key sozlanmagan bo'lsa qiymat o'zgarmaydi.
+func (h *Health) encryptField(ctx context.Context, userID, field, value string) (string, error) {
+	if h.Keys == nil {
+		return value, nil
+	}
+
+	version, key, err := h.currentDataKey(ctx, userID)
+	if err != nil {
+		h.Logger.Error("Failed to get data key", "user_id", userID, "error", err)
+		return "", status.Error(codes.Internal, "ma'lumotni shifrlab bo'lmadi")
+	}
+
+	encrypted, err := sealField(key, version, userID, field, value)
+	if err != nil {
+		return "", status.Error(codes.Internal, "ma'lumotni shifrlab bo'lmadi")
+	}
+	return encrypted, nil
+}
+
+// decryptField shifrlangan qiymatni ochadi, ochiq matnni o'zgarishsiz qaytaradi
+func (h *Health) decryptField(ctx context.Context, userID, field, value string) (string, error) {
+	rest, ok := strings.CutPrefix(value, encryptedPrefix)
+	if !ok {
+		return value, nil
+	}
+	if h.Keys == nil {
+		return "", errors.New("master key sozlanmagan")
+	}
+
+	versionStr, encoded, ok := strings.Cut(rest, ":")
+	version, err := strconv.Atoi(versionStr)
+	if !ok || err != nil {
+		return "", errors.New("shifrlangan qiymat formati noto'g'ri")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+
+	key, err := h.dataKey(ctx, userID, version)
+	if err != nil {
+		return "", err
+	}
+	plain, err := envelope.Open(key, sealed, fieldAAD(userID, field))
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// decryptRecord hujjatdagi shifrlangan maydonlarni joyida ochadi
+func (h *Health) decryptRecord(ctx context.Context, d *medicalRecordDoc) error {
+	description, err := h.decryptField(ctx, d.UserId, "description", d.Description)
+	if err != nil {
+		h.Logger.Error("Failed to decrypt medical record", "record_id", d.Id, "error", err)
+		return status.Error(codes.Internal, "tibbiy yozuvni ochib bo'lmadi")
+	}
+	d.Description = description
+
+	for i, a := range d.Attachments {
+		filename, err := h.decryptField(ctx, d.UserId, "attachments", a.Filename)
+		if err != nil {
+			h.Logger.Error("Failed to decrypt medical record", "record_id", d.Id, "error", err)
+			return status.Error(codes.Internal, "tibbiy yozuvni ochib bo'lmadi")
+		}
+		d.Attachments[i].Filename = filename
+	}
+	return nil
+}
+
+// RewrapDataKeys faol bo'lmagan master key bilan o'ralgan data key larni faol
+// master key bilan qayta o'raydi. Ma'lumotlarning o'zi qayta shifrlanmaydi,
+// shundan keyin eski master key ni config dan olib tashlash mumkin.
+func (h *Health) RewrapDataKeys(ctx context.Context) (int, error) {
+	if h.Keys == nil {
+		return 0, errors.New("master key sozlanmagan")
+	}
+
+	coll := h.Db.Collection("data_keys")
+	cursor, err := coll.Find(ctx, bson.M{"master_key_id": bson.M{"$ne": h.Keys.ActiveID()}})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	rewrapped := 0
+	for cursor.Next(ctx) {
+		var doc dataKeyDoc
+		if err := cursor.Decode(&doc); err != nil {
+			return rewrapped, err
+		}
+		key, err := h.unwrapDataKey(doc)
+		if err != nil {
+			return rewrapped, err
+		}
+		masterKeyID, wrapped, err := h.Keys.Wrap(key)
+		if err != nil {
+			return rewrapped, err
+		}
+
+		_, err = coll.UpdateOne(ctx,
+			bson.M{"user_id": doc.UserId, "version": doc.Version, "master_key_id": doc.MasterKeyId},
+			bson.M{"$set": bson.M{"master_key_id": masterKeyID, "wrapped_key": wrapped}},
+		)
+		if err != nil {
+			return rewrapped, err
+		}
+		rewrapped++
+	}
+	return rewrapped, cursor.Err()
+}
+
+// RotateDataKey foydalanuvchi uchun yangi data key yaratadi va uning barcha
+// tibbiy yozuvlarini (o'chirilganlarini ham) yangi kalit bilan qayta shifrlaydi.
+// Ochiq matnda qolgan eski yozuvlar ham shifrlanadi. Eski data key lar
+// o'chirilmaydi: rotatsiya yarmida to'xtasa qolgan yozuvlar ochilaveradi, GridFS
+// dagi fayl mazmuni esa yuklangandagi kalit bilan qoladi.
+func (h *Health) RotateDataKey(ctx context.Context, userID string) (int, error) {
+	if h.Keys == nil {
+		return 0, errors.New("master key sozlanmagan")
+	}
+
+	var latest dataKeyDoc
+	err := h.Db.Collection("data_keys").FindOne(ctx, bson.M{"user_id": userID},
+		options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}}),
+	).Decode(&latest)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, err
+	}
+	if _, _, err := h.newDataKey(ctx, userID, latest.Version+1); err != nil {
+		return 0, err
+	}
+
+	coll := h.Db.Collection("medical_records")
+	cursor, err := coll.Find(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	rotated := 0
+	for cursor.Next(ctx) {
+		var record medicalRecordDoc
+		if err := cursor.Decode(&record); err != nil {
+			return rotated, err
+		}
+		original := record.Description
+		if err := h.decryptRecord(ctx, &record); err != nil {
+			return rotated, err
+		}
+		description, err := h.encryptField(ctx, userID, "description", record.Description)
+		if err != nil {
+			return rotated, err
+		}
+		tokens, err := h.searchTokens(ctx, userID, record.Description)
+		if err != nil {
+			return rotated, err
+		}
+		attachments := record.Attachments
+		for i := range attachments {
+			attachments[i].Filename, err = h.encryptField(ctx, userID, "attachments", attachments[i].Filename)
+			if err != nil {
+				return rotated, err
+			}
+		}
+
+		// Shu orada yozuv yangilangan bo'lsa uni qayta yozib yubormaymiz:
+		// yangilanish allaqachon joriy kalit bilan shifrlangan
+		_, err = coll.UpdateOne(ctx,
+			bson.M{"id": record.Id, "description": original, "updated_at": record.UpdatedAt},
+			bson.M{"$set": bson.M{"description": description, "attachments": attachments, "search_tokens": tokens}},
+		)
+		if err != nil {
+			return rotated, err
+		}
+		rotated++
+	}
+	return rotated, cursor.Err()
+}
+
+// createDataKeyIndexes bir foydalanuvchi uchun bir xil versiyali ikki kalit
+// yaratilishiga yo'l qo'ymaydi
+func createDataKeyIndexes(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	names, err := db.Collection("data_keys").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "version", Value: -1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "master_key_id", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("Created data key indexes", "indexes", names)
+	return nil
+}
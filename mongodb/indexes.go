@@ -0,0 +1,256 @@
+package mongoDb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IndexSpec kodda e'lon qilingan index. Nomi MongoDB default nomi bilan bir
+// xil, masalan "user_id_1_seq_-1", shu nom bo'yicha bazadagisi bilan solishtiriladi.
+type IndexSpec struct {
+	Collection string
+	Keys       bson.D
+	Unique     bool
+	// Partial berilsa index faqat shu filtrga mos hujjatlarga qo'yiladi
+	Partial bson.D
+	// TTL bo'lsa MongoDB hujjatni Keys dagi sana maydonidan ExpireAfter o'tgach o'chiradi
+	TTL         bool
+	ExpireAfter time.Duration
+}
+
+// Name MongoDB bu kalitlar uchun beradigan default nom
+func (s IndexSpec) Name() string {
+	parts := make([]string, 0, len(s.Keys))
+	for _, k := range s.Keys {
+		parts = append(parts, fmt.Sprintf("%s_%v", k.Key, k.Value))
+	}
+	return strings.Join(parts, "_")
+}
+
+func (s IndexSpec) model() mongo.IndexModel {
+	opts := options.Index()
+	if s.Unique {
+		opts.SetUnique(true)
+	}
+	if s.Partial != nil {
+		opts.SetPartialFilterExpression(s.Partial)
+	}
+	if s.TTL {
+		opts.SetExpireAfterSeconds(int32(s.ExpireAfter.Seconds()))
+	}
+	return mongo.IndexModel{Keys: s.Keys, Options: opts}
+}
+
+func idIndex(collection string) IndexSpec {
+	return IndexSpec{Collection: collection, Keys: bson.D{{Key: "id", Value: 1}}, Unique: true}
+}
+
+func byUserAndTime(collection, timeField string) IndexSpec {
+	return IndexSpec{Collection: collection, Keys: bson.D{
+		{Key: "user_id", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: timeField, Value: -1}, {Key: "id", Value: -1},
+	}}
+}
+
+func byDeletedAt(collection string) IndexSpec {
+	return IndexSpec{Collection: collection, Keys: bson.D{{Key: "deleted_at", Value: 1}}}
+}
+
+// RequiredIndexes servis so'rovlari uchun kerakli indexlar. Startda
+// EnsureIndexes ularni bazadagisi bilan solishtiradi. Yangi index shu yerga
+// qo'shiladi, migratsiya faqat ma'lumotni o'zgartirish kerak bo'lganda yoziladi.
+var RequiredIndexes = []IndexSpec{
+	idIndex("medical_records"),
+	byUserAndTime("medical_records", "record_date"),
+	byUserAndTime("medical_records", "created_at"),
+	{Collection: "medical_records", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "search_tokens", Value: 1}}},
+	{Collection: "medical_records", Keys: bson.D{
+		{Key: "doctor_id", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "created_at", Value: -1}, {Key: "id", Value: -1},
+	}},
+	byDeletedAt("medical_records"),
+
+	idIndex("lifestyle_data"),
+	byUserAndTime("lifestyle_data", "recorded_date"),
+	byDeletedAt("lifestyle_data"),
+
+	idIndex("wearable_data"),
+	{Collection: "wearable_data", Keys: bson.D{{Key: "ingest_key", Value: 1}}, Unique: true},
+	byUserAndTime("wearable_data", "recorded_timestamp"),
+	byDeletedAt("wearable_data"),
+
+	idIndex("health"),
+	{Collection: "health", Keys: bson.D{
+		{Key: "user_id", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "priority", Value: 1}, {Key: "created_at", Value: -1}, {Key: "id", Value: 1},
+	}},
+
+	idIndex("alerts"),
+	{Collection: "alerts", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "id", Value: -1}}},
+	// expires_at AcknowledgeAlert da qo'yiladi, tasdiqlanmagan alertlar o'chmaydi
+	{Collection: "alerts", Keys: bson.D{{Key: "expires_at", Value: 1}}, TTL: true},
+
+	idIndex("alert_thresholds"),
+	{Collection: "alert_thresholds", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "data_type", Value: 1}, {Key: "deleted_at", Value: 1}}},
+
+	activeConsentIndex,
+	{Collection: "doctor_consents", Keys: bson.D{{Key: "doctor_id", Value: 1}, {Key: "revoked_at", Value: 1}}},
+
+	{Collection: "audit_log", Keys: bson.D{{Key: "seq", Value: 1}}, Unique: true},
+	{Collection: "audit_log", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "seq", Value: -1}}},
+	{Collection: "audit_log", Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "seq", Value: -1}}},
+	{Collection: "audit_log", Keys: bson.D{{Key: "resource_type", Value: 1}, {Key: "resource_id", Value: 1}, {Key: "seq", Value: -1}}},
+
+	{Collection: "data_keys", Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "version", Value: -1}}, Unique: true},
+	{Collection: "data_keys", Keys: bson.D{{Key: "master_key_id", Value: 1}}},
+
+	{Collection: "dead_letters", Keys: bson.D{{Key: "queue", Value: 1}, {Key: "message_id", Value: 1}, {Key: "dead_lettered_at", Value: 1}}, Unique: true},
+	{Collection: "dead_letters", Keys: bson.D{{Key: "queue", Value: 1}, {Key: "dead_lettered_at", Value: 1}, {Key: "id", Value: 1}}},
+	idIndex("dead_letters"),
+
+	{Collection: "user_settings", Keys: bson.D{{Key: "user_id", Value: 1}}, Unique: true},
+
+	{Collection: migrationsCollection, Keys: bson.D{{Key: "version", Value: 1}}, Unique: true},
+}
+
+// activeConsentIndex bemor-shifokor juftligi uchun faqat bitta faol (revoked_at
+// null) rozilik bo'lishini ta'minlaydi
+var activeConsentIndex = IndexSpec{
+	Collection: "doctor_consents",
+	Keys:       bson.D{{Key: "patient_id", Value: 1}, {Key: "doctor_id", Value: 1}},
+	Unique:     true,
+	Partial:    bson.D{{Key: "revoked_at", Value: bson.D{{Key: "$type", Value: "null"}}}},
+}
+
+// IndexDrift kodda e'lon qilingan va bazadagi indexlar orasidagi farq
+type IndexDrift struct {
+	Collection string
+	Name       string
+	// Kind: created (yo'q edi, yaratildi), mismatch (nomi bir xil, ta'rifi
+	// boshqa), undeclared (bazada bor, kodda yo'q) yoki failed (yaratilmadi)
+	Kind   string
+	Detail string
+}
+
+const (
+	IndexCreated    = "created"
+	IndexMismatch   = "mismatch"
+	IndexUndeclared = "undeclared"
+	IndexFailed     = "failed"
+)
+
+// existingIndex listIndexes javobidagi solishtiriladigan maydonlar
+type existingIndex struct {
+	Name               string   `bson:"name"`
+	Key                bson.D   `bson:"key"`
+	Unique             bool     `bson:"unique"`
+	ExpireAfterSeconds *float64 `bson:"expireAfterSeconds"`
+	Partial            bson.Raw `bson:"partialFilterExpression"`
+}
+
+// EnsureIndexes RequiredIndexes ni bazadagi indexlar bilan solishtiradi: yo'qlarini
+// yaratadi, qolgan farqlarni qaytaradi. Mavjud indexlar o'zgartirilmaydi va
+// o'chirilmaydi, mismatch va undeclared larni qo'lda hal qilish kerak. Bitta
+// index yaratilmasa (masalan unique index ga takror qiymatlar xalaqit bersa) u
+// failed bo'lib qaytadi va qolganlari davom etadi.
+func EnsureIndexes(ctx context.Context, db *mongo.Database) ([]IndexDrift, error) {
+	var drift []IndexDrift
+
+	var collections []string
+	specs := map[string][]IndexSpec{}
+	for _, s := range RequiredIndexes {
+		if _, ok := specs[s.Collection]; !ok {
+			collections = append(collections, s.Collection)
+		}
+		specs[s.Collection] = append(specs[s.Collection], s)
+	}
+
+	for _, collection := range collections {
+		coll := db.Collection(collection)
+		cursor, err := coll.Indexes().List(ctx)
+		if err != nil {
+			return drift, fmt.Errorf("failed to list %s indexes: %v", collection, err)
+		}
+		var existing []existingIndex
+		if err := cursor.All(ctx, &existing); err != nil {
+			return drift, fmt.Errorf("failed to decode %s indexes: %v", collection, err)
+		}
+		byName := map[string]existingIndex{}
+		for _, idx := range existing {
+			byName[idx.Name] = idx
+		}
+
+		declared := map[string]bool{"_id_": true}
+		for _, s := range specs[collection] {
+			name := s.Name()
+			declared[name] = true
+
+			idx, ok := byName[name]
+			if ok {
+				if diff := s.diff(idx); diff != "" {
+					drift = append(drift, IndexDrift{Collection: collection, Name: name, Kind: IndexMismatch, Detail: diff})
+				}
+				continue
+			}
+			if _, err := coll.Indexes().CreateOne(ctx, s.model()); err != nil {
+				drift = append(drift, IndexDrift{Collection: collection, Name: name, Kind: IndexFailed, Detail: err.Error()})
+				continue
+			}
+			drift = append(drift, IndexDrift{Collection: collection, Name: name, Kind: IndexCreated})
+		}
+
+		for _, idx := range existing {
+			if !declared[idx.Name] {
+				drift = append(drift, IndexDrift{Collection: collection, Name: idx.Name, Kind: IndexUndeclared, Detail: fmt.Sprint(idx.Key)})
+			}
+		}
+	}
+	return drift, nil
+}
+
+// diff e'lon qilingan index bazadagisidan farq qilsa farqni tavsiflaydi
+func (s IndexSpec) diff(idx existingIndex) string {
+	var diffs []string
+	if !sameKeys(s.Keys, idx.Key) {
+		diffs = append(diffs, fmt.Sprintf("keys %v != %v", s.Keys, idx.Key))
+	}
+	if s.Unique != idx.Unique {
+		diffs = append(diffs, fmt.Sprintf("unique %v != %v", s.Unique, idx.Unique))
+	}
+	switch {
+	case s.TTL && idx.ExpireAfterSeconds == nil:
+		diffs = append(diffs, "TTL yo'q")
+	case !s.TTL && idx.ExpireAfterSeconds != nil:
+		diffs = append(diffs, "kutilmagan TTL")
+	case s.TTL && *idx.ExpireAfterSeconds != s.ExpireAfter.Seconds():
+		diffs = append(diffs, fmt.Sprintf("expireAfterSeconds %v != %v", s.ExpireAfter.Seconds(), *idx.ExpireAfterSeconds))
+	}
+	var partial string
+	if s.Partial != nil {
+		raw, _ := bson.Marshal(s.Partial)
+		partial = bson.Raw(raw).String()
+	}
+	if existing := idx.Partial; partial != "" || existing != nil {
+		if existing == nil || existing.String() != partial {
+			diffs = append(diffs, fmt.Sprintf("partialFilterExpression %s != %v", partial, existing))
+		}
+	}
+	return strings.Join(diffs, "; ")
+}
+
+// sameKeys kalitlar tartibi va yo'nalishini solishtiradi. Bazada yo'nalish
+// int32, int64 yoki double bo'lib qaytishi mumkin.
+func sameKeys(want, got bson.D) bool {
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		if want[i].Key != got[i].Key || fmt.Sprint(want[i].Value) != fmt.Sprint(got[i].Value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,111 @@
+package mongoDb
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestIndexSpecName(t *testing.T) {
+	tests := []struct {
+		spec IndexSpec
+		want string
+	}{
+		{idIndex("alerts"), "id_1"},
+		{byUserAndTime("wearable_data", "recorded_timestamp"), "user_id_1_deleted_at_1_recorded_timestamp_-1_id_-1"},
+		{activeConsentIndex, "patient_id_1_doctor_id_1"},
+	}
+	for _, tt := range tests {
+		if got := tt.spec.Name(); got != tt.want {
+			t.Errorf("Name() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestRequiredIndexNamesAreUnique(t *testing.T) {
+	seen := map[string]bool{}
+	for _, spec := range RequiredIndexes {
+		key := spec.Collection + "." + spec.Name()
+		if seen[key] {
+			t.Errorf("index %s declared twice", key)
+		}
+		seen[key] = true
+	}
+}
+
+func TestSameKeys(t *testing.T) {
+	want := bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}}
+	tests := []struct {
+		name string
+		got  bson.D
+		same bool
+	}{
+		{"int32", bson.D{{Key: "user_id", Value: int32(1)}, {Key: "created_at", Value: int32(-1)}}, true},
+		{"int64 and double", bson.D{{Key: "user_id", Value: int64(1)}, {Key: "created_at", Value: float64(-1)}}, true},
+		{"other direction", bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: 1}}, false},
+		{"other order", bson.D{{Key: "created_at", Value: -1}, {Key: "user_id", Value: 1}}, false},
+		{"prefix", bson.D{{Key: "user_id", Value: 1}}, false},
+		{"text index", bson.D{{Key: "user_id", Value: "text"}, {Key: "created_at", Value: -1}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sameKeys(want, tt.got); got != tt.same {
+				t.Errorf("sameKeys() = %v, want %v", got, tt.same)
+			}
+		})
+	}
+}
+
+func TestIndexSpecDiff(t *testing.T) {
+	seconds := func(d time.Duration) *float64 {
+		s := d.Seconds()
+		return &s
+	}
+	partial := func(d bson.D) bson.Raw {
+		raw, err := bson.Marshal(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return raw
+	}
+	ttl := IndexSpec{Collection: "alerts", Keys: bson.D{{Key: "expires_at", Value: 1}}, TTL: true, ExpireAfter: time.Hour}
+	consentPartial := bson.D{{Key: "revoked_at", Value: bson.D{{Key: "$type", Value: "null"}}}}
+
+	tests := []struct {
+		name     string
+		spec     IndexSpec
+		existing existingIndex
+		want     []string
+	}{
+		{"same", idIndex("alerts"), existingIndex{Key: bson.D{{Key: "id", Value: int32(1)}}, Unique: true}, nil},
+		{"keys", idIndex("alerts"), existingIndex{Key: bson.D{{Key: "id", Value: int32(-1)}}, Unique: true}, []string{"keys"}},
+		{"not unique", idIndex("alerts"), existingIndex{Key: bson.D{{Key: "id", Value: 1}}}, []string{"unique true != false"}},
+		{"same ttl", ttl, existingIndex{Key: bson.D{{Key: "expires_at", Value: 1}}, ExpireAfterSeconds: seconds(time.Hour)}, nil},
+		{"missing ttl", ttl, existingIndex{Key: bson.D{{Key: "expires_at", Value: 1}}}, []string{"TTL yo'q"}},
+		{"other ttl", ttl, existingIndex{Key: bson.D{{Key: "expires_at", Value: 1}}, ExpireAfterSeconds: seconds(time.Minute)}, []string{"expireAfterSeconds 3600 != 60"}},
+		{"unexpected ttl", idIndex("alerts"), existingIndex{Key: bson.D{{Key: "id", Value: 1}}, Unique: true, ExpireAfterSeconds: seconds(time.Hour)}, []string{"kutilmagan TTL"}},
+		{"same partial", activeConsentIndex, existingIndex{Key: activeConsentIndex.Keys, Unique: true, Partial: partial(consentPartial)}, nil},
+		{"old partial", activeConsentIndex, existingIndex{Key: activeConsentIndex.Keys, Unique: true, Partial: partial(bson.D{{Key: "revoked_at", Value: "0"}})}, []string{"partialFilterExpression"}},
+		{"missing partial", activeConsentIndex, existingIndex{Key: activeConsentIndex.Keys, Unique: true}, []string{"partialFilterExpression"}},
+		{"unexpected partial", idIndex("alerts"), existingIndex{Key: bson.D{{Key: "id", Value: 1}}, Unique: true, Partial: partial(consentPartial)}, []string{"partialFilterExpression"}},
+		{"several", idIndex("alerts"), existingIndex{Key: bson.D{{Key: "id", Value: -1}}}, []string{"keys", "unique"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.spec.diff(tt.existing)
+			if len(tt.want) == 0 {
+				if got != "" {
+					t.Errorf("diff() = %q, want no difference", got)
+				}
+				return
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("diff() = %q, want it to mention %q", got, w)
+				}
+			}
+		})
+	}
+}
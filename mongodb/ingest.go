@@ -0,0 +1,223 @@
+package mongoDb
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Wearable ingest hisoblagichlari expvar orqali /debug/vars da ko'rinadi
+var (
+	wearableIngested   = expvar.NewInt("wearable_data_ingested_total")
+	wearableDuplicates = expvar.NewInt("wearable_data_duplicates_total")
+)
+
+// ingestTimeLayout natural kalitdagi vaqt: UTC, millisekundgacha. MongoDB
+// $dateToString ning default formati bilan bir xil, migratsiya ham shu kalitni hosil qiladi.
+const ingestTimeLayout = "2006-01-02T15:04:05.000Z"
+
+// wearableIngestKey o'lchov uchun idempotentlik kaliti: bir qurilmadan bir
+// vaqtda kelgan bir turdagi ikkinchi o'lchov takror hisoblanadi. Xabar id si
+// kalitga kirmaydi, chunki bir o'lchov id bilan ham, id siz ham kelishi mumkin.
+func wearableIngestKey(userID, deviceType, dataType string, recordedTimestamp time.Time) string {
+	return "natural:" + strings.Join([]string{userID, deviceType, dataType, recordedTimestamp.UTC().Format(ingestTimeLayout)}, "|")
+}
+
+// isIngestKeyDuplicate xatolik ingest_key unique index idagi to'qnashuv ekanini tekshiradi
+func isIngestKeyDuplicate(we mongo.WriteError) bool {
+	return mongo.IsDuplicateKeyError(we) && strings.Contains(we.Message, "ingest_key_1")
+}
+
+// ingestWriteError upsert xatoligini baholaydi. Parallel upsert larda, shu
+// batch ichida ham, ikkinchisi ingest_key unique index iga urilishi mumkin: bu
+// takror, xatolik emas. id dagi to'qnashuv esa shu id bilan boshqa o'lchov
+// yozilganini bildiradi: qayta urinish natijani o'zgartirmaydi, xabar darhol
+// dead-letter ga ketadi.
+func ingestWriteError(doc wearableDataDoc, we mongo.WriteError) error {
+	switch {
+	case isIngestKeyDuplicate(we):
+		return nil
+	case mongo.IsDuplicateKeyError(we):
+		return permanent(fmt.Errorf("id %q belongs to another reading: %w", doc.Id, we))
+	default:
+		return we
+	}
+}
+
+// ingestWearableBatch o'lchovlarni ingest_key bo'yicha bitta tartibsiz BulkWrite
+// bilan upsert qiladi va har biri uchun yangi yozilganini hamda xatolikni
+// qaytaradi. Shu kalitli hujjat allaqachon bo'lsa o'lchov takror hisoblanadi:
+// hech narsa yozilmaydi, inserted false va xatolik nil bo'ladi.
+func (h *Health) ingestWearableBatch(ctx context.Context, docs []wearableDataDoc) ([]bool, []error) {
+	inserted := make([]bool, len(docs))
+	errs := make([]error, len(docs))
+	if len(docs) == 0 {
+		return inserted, errs
+	}
+
+	models := make([]mongo.WriteModel, len(docs))
+	for i, doc := range docs {
+		models[i] = mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"ingest_key": doc.IngestKey}).
+			SetUpdate(bson.M{"$setOnInsert": doc}).
+			SetUpsert(true)
+	}
+
+	result, err := h.Db.Collection("wearable_data").BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	var bulkErr mongo.BulkWriteException
+	switch {
+	case errors.As(err, &bulkErr):
+		for _, we := range bulkErr.WriteErrors {
+			errs[we.Index] = ingestWriteError(docs[we.Index], we.WriteError)
+		}
+		// Yozuv saqlanganiga ishonch yo'q, qayta urinish upsert tufayli xavfsiz
+		if bulkErr.WriteConcernError != nil {
+			for i := range errs {
+				if errs[i] == nil {
+					errs[i] = bulkErr.WriteConcernError
+				}
+			}
+		}
+	case err != nil:
+		for i := range errs {
+			errs[i] = err
+		}
+		return inserted, errs
+	}
+
+	if result != nil {
+		for i := range result.UpsertedIDs {
+			inserted[i] = true
+		}
+	}
+	for i := range docs {
+		switch {
+		case errs[i] != nil:
+		case inserted[i]:
+			wearableIngested.Add(1)
+		default:
+			wearableDuplicates.Add(1)
+		}
+	}
+	return inserted, errs
+}
+
+// createWearableIngestIndex bir xil id bilan takror yozilgan o'lchovlarning
+// birinchisidan boshqasini o'chiradi, eski hujjatlarga ingest_key yozadi (id si
+// yo'qlariga _id dan) va ingest_key ga unique index qo'yadi
+func createWearableIngestIndex(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	coll := db.Collection("wearable_data")
+
+	cursor, err := coll.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"ingest_key": bson.M{"$exists": false},
+			"id":         bson.M{"$type": "string", "$ne": ""},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$id",
+			"extra": bson.M{"$push": "$_id"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$match", Value: bson.M{"count": bson.M{"$gt": 1}}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to find duplicate wearable data: %v", err)
+	}
+	var groups []struct {
+		Id    string        `bson:"_id"`
+		Extra []interface{} `bson:"extra"`
+	}
+	if err := cursor.All(ctx, &groups); err != nil {
+		return err
+	}
+
+	removed := int64(0)
+	for _, g := range groups {
+		result, err := coll.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": g.Extra[1:]}})
+		if err != nil {
+			return fmt.Errorf("failed to remove duplicate wearable data %s: %v", g.Id, err)
+		}
+		removed += result.DeletedCount
+	}
+
+	backfilled, err := coll.UpdateMany(ctx,
+		bson.M{"ingest_key": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"ingest_key": bson.M{"$cond": bson.A{
+				bson.M{"$in": bson.A{bson.M{"$ifNull": bson.A{"$id", ""}}, bson.A{""}}},
+				bson.M{"$concat": bson.A{"legacy:", bson.M{"$toString": "$_id"}}},
+				bson.M{"$concat": bson.A{"id:", "$id"}},
+			}},
+		}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to backfill ingest_key: %v", err)
+	}
+
+	name, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "ingest_key", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("Created wearable ingest index", "index", name, "duplicates_removed", removed, "backfilled", backfilled.ModifiedCount)
+	return nil
+}
+
+// naturalWearableIngestKeys ingest_key ni wearableIngestKey dagi natural kalitga
+// o'tkazadi. Bir xil o'lchovli hujjatlardan faqat birinchisi (_id bo'yicha)
+// natural kalitni oladi, qolganlari eski kaliti bilan qoladi. Natural kalit
+// allaqachon biror hujjatda (masalan id siz kelgan keyingi o'lchovda) bo'lsa
+// guruh o'tkazib yuboriladi, aks holda $merge unique index ga urilardi. Hech
+// narsa o'chirilmaydi.
+func naturalWearableIngestKeys(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	coll := db.Collection("wearable_data")
+	_, err := coll.Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"recorded_timestamp": bson.M{"$type": "date"}}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"$concat": bson.A{
+				"natural:",
+				bson.M{"$ifNull": bson.A{"$user_id", ""}}, "|",
+				bson.M{"$ifNull": bson.A{"$device_type", ""}}, "|",
+				bson.M{"$ifNull": bson.A{"$data_type", ""}}, "|",
+				bson.M{"$dateToString": bson.M{"date": "$recorded_timestamp", "format": "%Y-%m-%dT%H:%M:%S.%LZ"}},
+			}},
+			"first": bson.M{"$first": "$_id"},
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "wearable_data",
+			"localField":   "_id",
+			"foreignField": "ingest_key",
+			"as":           "holders",
+		}}},
+		{{Key: "$match", Value: bson.M{"holders": bson.M{"$size": 0}}}},
+		{{Key: "$project", Value: bson.M{"_id": "$first", "ingest_key": "$_id"}}},
+		{{Key: "$merge", Value: bson.M{
+			"into":           "wearable_data",
+			"on":             "_id",
+			"whenMatched":    bson.A{bson.M{"$set": bson.M{"ingest_key": "$$new.ingest_key"}}},
+			"whenNotMatched": "discard",
+		}}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rewrite wearable ingest keys: %v", err)
+	}
+
+	natural, err := coll.CountDocuments(ctx, bson.M{"ingest_key": bson.M{"$regex": "^natural:"}})
+	if err != nil {
+		return err
+	}
+	log.Info("Rewrote wearable ingest keys", "natural", natural)
+	return nil
+}
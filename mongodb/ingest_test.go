@@ -0,0 +1,84 @@
+package mongoDb
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestWearableIngestKey(t *testing.T) {
+	base := time.Date(2026, 3, 1, 8, 30, 0, 0, time.UTC)
+	tashkent := time.FixedZone("UTC+5", 5*60*60)
+	key := wearableIngestKey("user-1", "watch", "heart_rate", base)
+
+	if want := "natural:user-1|watch|heart_rate|2026-03-01T08:30:00.000Z"; key != want {
+		t.Fatalf("wearableIngestKey() = %q, want %q", key, want)
+	}
+
+	tests := []struct {
+		name     string
+		other    string
+		wantSame bool
+	}{
+		{"same instant in another zone", wearableIngestKey("user-1", "watch", "heart_rate", base.In(tashkent)), true},
+		{"sub-millisecond difference", wearableIngestKey("user-1", "watch", "heart_rate", base.Add(300*time.Microsecond)), true},
+		{"next millisecond", wearableIngestKey("user-1", "watch", "heart_rate", base.Add(time.Millisecond)), false},
+		{"other user", wearableIngestKey("user-2", "watch", "heart_rate", base), false},
+		{"other device", wearableIngestKey("user-1", "ring", "heart_rate", base), false},
+		{"other data type", wearableIngestKey("user-1", "watch", "spo2", base), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if (tt.other == key) != tt.wantSame {
+				t.Errorf("key %q vs %q, want same = %v", tt.other, key, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestIsIngestKeyDuplicate(t *testing.T) {
+	tests := []struct {
+		name string
+		err  mongo.WriteError
+		want bool
+	}{
+		{"ingest_key collision", mongo.WriteError{Code: 11000, Message: "E11000 duplicate key error collection: health.wearable_data index: ingest_key_1 dup key: { ingest_key: \"natural:...\" }"}, true},
+		{"id collision", mongo.WriteError{Code: 11000, Message: "E11000 duplicate key error collection: health.wearable_data index: id_1 dup key: { id: \"abc\" }"}, false},
+		{"other error", mongo.WriteError{Code: 121, Message: "Document failed validation ingest_key_1"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isIngestKeyDuplicate(tt.err); got != tt.want {
+				t.Errorf("isIngestKeyDuplicate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIngestWriteError(t *testing.T) {
+	doc := wearableDataDoc{Id: "abc"}
+	tests := []struct {
+		name          string
+		err           mongo.WriteError
+		wantErr       bool
+		wantPermanent bool
+	}{
+		{"ingest_key collision is a duplicate", mongo.WriteError{Code: 11000, Message: "E11000 duplicate key error collection: health.wearable_data index: ingest_key_1 dup key: { ingest_key: \"natural:...\" }"}, false, false},
+		{"id collision is permanent", mongo.WriteError{Code: 11000, Message: "E11000 duplicate key error collection: health.wearable_data index: id_1 dup key: { id: \"abc\" }"}, true, true},
+		{"other error is retried", mongo.WriteError{Code: 121, Message: "Document failed validation"}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ingestWriteError(doc, tt.err)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ingestWriteError() = %v, want error %v", err, tt.wantErr)
+			}
+			var perm permanentError
+			if errors.As(err, &perm) != tt.wantPermanent {
+				t.Errorf("ingestWriteError() = %v, want permanent %v", err, tt.wantPermanent)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package mongoDb
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	pb "health/genproto/health_analytics"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// recordedDoc vaqt maydoni bo'yicha sahifalanadigan hujjat. recordedKey
+// saralash maydonining qiymati va id ni qaytaradi.
+type recordedDoc interface {
+	recordedKey(field string) (recorded time.Time, id string)
+}
+
+func (d lifestyleDataDoc) recordedKey(string) (time.Time, string) { return d.RecordedDate, d.Id }
+func (d wearableDataDoc) recordedKey(string) (time.Time, string)  { return d.RecordedTimestamp, d.Id }
+
+func (d medicalRecordDoc) recordedKey(field string) (time.Time, string) {
+	if field == "created_at" {
+		return d.CreatedAt, d.Id
+	}
+	return d.RecordDate, d.Id
+}
+
+type recordedCursor struct {
+	Recorded time.Time `json:"r"`
+	Id       string    `json:"i"`
+}
+
+// recordedQuery o'lchovlar ro'yxati so'rovi. Filter cursor siz filtr, u
+// total_count uchun ham ishlatiladi.
+type recordedQuery struct {
+	Filter    bson.M
+	TimeField string
+	Order     pb.SortOrder
+	Limit     int64
+	Cursor    string
+}
+
+// listRecorded filtrga mos hujjatlarni TimeField, keyin id bo'yicha saralab
+// sahifalaydi. Keyingi sahifa cursor i va cursor ga bog'liq bo'lmagan umumiy
+// sonni qaytaradi.
+func listRecorded[T recordedDoc](ctx context.Context, coll *mongo.Collection, q recordedQuery) ([]T, string, int64, error) {
+	total, err := coll.CountDocuments(ctx, q.Filter)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	direction, cmp := -1, "$lt"
+	if q.Order == pb.SortOrder_SORT_ORDER_ASC {
+		direction, cmp = 1, "$gt"
+	}
+
+	filter := q.Filter
+	if q.Cursor != "" {
+		var c recordedCursor
+		if err := decodeCursor(q.Cursor, &c); err != nil {
+			return nil, "", 0, err
+		}
+		filter = bson.M{"$and": bson.A{q.Filter, bson.M{"$or": bson.A{
+			bson.M{q.TimeField: bson.M{cmp: c.Recorded}},
+			bson.M{q.TimeField: c.Recorded, "id": bson.M{cmp: c.Id}},
+		}}}}
+	}
+
+	limit := pageSize(q.Limit)
+	cursor, err := coll.Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{{Key: q.TimeField, Value: direction}, {Key: "id", Value: direction}}).
+			SetLimit(limit+1),
+	)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []T
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, "", 0, err
+	}
+
+	var next string
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		recorded, id := docs[len(docs)-1].recordedKey(q.TimeField)
+		if next, err = encodeCursor(recordedCursor{Recorded: recorded, Id: id}); err != nil {
+			return nil, "", 0, err
+		}
+	}
+	return docs, next, total, nil
+}
+
+// listFilter ro'yxat uchun umumiy filtr: o'chirilmaganlar, user_id berilsa
+// faqat uniki (ruxsatni interceptor tekshirgan), aks holda chaqiruvchiniki
+func listFilter(ctx context.Context, userID, dataType string) bson.M {
+	filter := bson.M{"deleted_at": nil}
+	if userID != "" {
+		filter["user_id"] = userID
+	} else {
+		filter = callerFilter(ctx, filter)
+	}
+	if dataType != "" {
+		filter["data_type"] = dataType
+	}
+	return filter
+}
+
+// normalizeSlashDates "2006/01/02" ko'rinishidagi sanalarni "2006-01-02" ga
+// o'tkazadi, shunda sana bo'yicha saralash va oraliq to'g'ri ishlaydi
+func normalizeSlashDates(ctx context.Context, db *mongo.Database, log *slog.Logger, collection, field string) error {
+	result, err := db.Collection(collection).UpdateMany(ctx,
+		bson.M{field: bson.M{"$regex": `^\d{4}/\d{2}/\d{2}$`}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			field: bson.M{"$replaceAll": bson.M{"input": "$" + field, "find": "/", "replacement": "-"}},
+		}}}},
+	)
+	if err != nil {
+		return err
+	}
+	log.Info("Normalised date format", "collection", collection, "field", field, "modified", result.ModifiedCount)
+	return nil
+}
+
+func normalizeLifestyleDates(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	return normalizeSlashDates(ctx, db, log, "lifestyle_data", "recorded_date")
+}
+
+func normalizeMedicalRecordDates(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	return normalizeSlashDates(ctx, db, log, "medical_records", "record_date")
+}
+
+// createRecordedIndexes ro'yxatlar uchun: foydalanuvchi, keyin o'lchov vaqti va id
+func createRecordedIndexes(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	for collection, timeField := range map[string]string{
+		"wearable_data":  "recorded_timestamp",
+		"lifestyle_data": "recorded_date",
+	} {
+		name, err := db.Collection(collection).Indexes().CreateOne(ctx, mongo.IndexModel{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "deleted_at", Value: 1},
+				{Key: timeField, Value: -1},
+				{Key: "id", Value: -1},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		log.Info("Created list index", "collection", collection, "index", name)
+	}
+	return nil
+}
+
+// createMedicalRecordListIndexes ListMedicalRecords dagi saralashlar va
+// description bo'yicha qidiruv uchun
+func createMedicalRecordListIndexes(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	names, err := db.Collection("medical_records").Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "record_date", Value: -1}, {Key: "id", Value: -1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "deleted_at", Value: 1}, {Key: "created_at", Value: -1}, {Key: "id", Value: -1}}},
+		{Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "search_tokens", Value: 1}}},
+	})
+	if err != nil {
+		return err
+	}
+	log.Info("Created medical record list indexes", "indexes", names)
+	return nil
+}
@@ -0,0 +1,158 @@
+package mongoDb
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	pb "health/genproto/health_analytics"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// canonicalUnits data_type uchun saqlanadigan asosiy birlik. Bu ro'yxatdagi
+// data_type lar uchun qiymat son bo'lishi shart, qolganlari uchun ixtiyoriy.
+var canonicalUnits = map[string]string{
+	"heart_rate":     "bpm",
+	"steps":          "steps",
+	"sleep":          "h",
+	"calories":       "kcal",
+	"weight":         "kg",
+	"height":         "cm",
+	"temperature":    "°C",
+	"spo2":           "%",
+	"blood_pressure": "mmHg",
+	"distance":       "km",
+	"glucose":        "mg/dL",
+	"water":          "l",
+}
+
+// unitConversions asosiy birlik -> kiruvchi birlik -> {ko'paytuvchi, qo'shiluvchi}.
+// asosiy = qiymat*ko'paytuvchi + qo'shiluvchi. Kalitlar kichik harfda.
+var unitConversions = map[string]map[string][2]float64{
+	"bpm":   {"bpm": {1, 0}},
+	"steps": {"steps": {1, 0}, "step": {1, 0}},
+	"h":     {"h": {1, 0}, "hr": {1, 0}, "hrs": {1, 0}, "hour": {1, 0}, "hours": {1, 0}, "min": {1.0 / 60, 0}, "mins": {1.0 / 60, 0}, "minutes": {1.0 / 60, 0}},
+	"kcal":  {"kcal": {1, 0}, "cal": {1, 0}, "kj": {1 / 4.184, 0}},
+	"kg":    {"kg": {1, 0}, "kgs": {1, 0}, "g": {0.001, 0}, "lb": {0.45359237, 0}, "lbs": {0.45359237, 0}},
+	"cm":    {"cm": {1, 0}, "m": {100, 0}, "mm": {0.1, 0}, "in": {2.54, 0}, "ft": {30.48, 0}},
+	"°C":    {"c": {1, 0}, "°c": {1, 0}, "celsius": {1, 0}, "f": {5.0 / 9, -160.0 / 9}, "°f": {5.0 / 9, -160.0 / 9}, "fahrenheit": {5.0 / 9, -160.0 / 9}},
+	"%":     {"%": {1, 0}},
+	"mmHg":  {"mmhg": {1, 0}},
+	"km":    {"km": {1, 0}, "m": {0.001, 0}, "mi": {1.609344, 0}},
+	"mg/dL": {"mg/dl": {1, 0}, "mmol/l": {18.0, 0}},
+	"l":     {"l": {1, 0}, "ml": {0.001, 0}},
+}
+
+var (
+	bloodPressurePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*/\s*(\d+(?:\.\d+)?)\s*(?:mmhg)?$`)
+	numberUnitPattern    = regexp.MustCompile(`^([-+]?\d+(?:\.\d+)?)\s*(.*)$`)
+	thousandsPattern     = regexp.MustCompile(`^\d{1,3}(?:,\d{3})+(?:\.\d+)?(?:\D.*)?$`)
+)
+
+// measurementDoc hujjatlardagi measurement maydoni
+type measurementDoc struct {
+	Value      float64            `bson:"value"`
+	Unit       string             `bson:"unit"`
+	Components map[string]float64 `bson:"components,omitempty"`
+}
+
+func newMeasurementDoc(m *pb.Measurement) *measurementDoc {
+	if m == nil {
+		return nil
+	}
+	return &measurementDoc{Value: m.Value, Unit: m.Unit, Components: m.Components}
+}
+
+func (d *measurementDoc) toProto() *pb.Measurement {
+	if d == nil {
+		return nil
+	}
+	return &pb.Measurement{Value: d.Value, Unit: d.Unit, Components: d.Components}
+}
+
+// convertUnit qiymatni data_type ning asosiy birligiga o'tkazadi. Birlik
+// berilmagan bo'lsa qiymat allaqachon asosiy birlikda deb hisoblanadi.
+func convertUnit(dataType string, value float64, unit string) (float64, string, error) {
+	canonical, known := canonicalUnits[dataType]
+	if !known {
+		return value, unit, nil
+	}
+
+	unit = strings.ToLower(strings.TrimSpace(unit))
+	if unit == "" || unit == strings.ToLower(canonical) {
+		return value, canonical, nil
+	}
+
+	conversion, ok := unitConversions[canonical][unit]
+	if !ok {
+		return 0, "", status.Errorf(codes.InvalidArgument, "%s uchun noma'lum birlik: %q", dataType, unit)
+	}
+	return value*conversion[0] + conversion[1], canonical, nil
+}
+
+// normalizeMeasurement o'lchovni asosiy birlikka keltiradi. m berilmagan bo'lsa
+// u raw satrdan ajratib olinadi ("72", "180 lbs", "98.6 °F", "120/80").
+// Son bo'lishi shart bo'lmagan data_type uchun satr son bo'lmasa nil qaytadi.
+func normalizeMeasurement(dataType, raw string, m *pb.Measurement) (*pb.Measurement, error) {
+	if m != nil {
+		value, unit, err := convertUnit(dataType, m.Value, m.Unit)
+		if err != nil {
+			return nil, err
+		}
+		var components map[string]float64
+		if len(m.Components) > 0 {
+			components = make(map[string]float64, len(m.Components))
+			for k, v := range m.Components {
+				if components[k], _, err = convertUnit(dataType, v, m.Unit); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return &pb.Measurement{Value: value, Unit: unit, Components: components}, nil
+	}
+
+	raw = strings.TrimSpace(raw)
+	// "1,200" ming ajratuvchisi, "36,6" esa o'nli vergul
+	if thousandsPattern.MatchString(raw) {
+		raw = strings.ReplaceAll(raw, ",", "")
+	} else {
+		raw = strings.ReplaceAll(raw, ",", ".")
+	}
+	lower := strings.ToLower(raw)
+
+	if dataType == "blood_pressure" {
+		match := bloodPressurePattern.FindStringSubmatch(lower)
+		if match == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "qon bosimi 120/80 ko'rinishida bo'lishi kerak: %q", raw)
+		}
+		systolic, _ := strconv.ParseFloat(match[1], 64)
+		diastolic, _ := strconv.ParseFloat(match[2], 64)
+		return &pb.Measurement{
+			Value:      systolic,
+			Unit:       canonicalUnits[dataType],
+			Components: map[string]float64{"systolic": systolic, "diastolic": diastolic},
+		}, nil
+	}
+
+	match := numberUnitPattern.FindStringSubmatch(lower)
+	if match == nil {
+		if _, known := canonicalUnits[dataType]; known {
+			return nil, status.Errorf(codes.InvalidArgument, "%s qiymati son bo'lishi kerak: %q", dataType, raw)
+		}
+		return nil, nil
+	}
+
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s qiymati son bo'lishi kerak: %q", dataType, raw)
+	}
+	// Noma'lum data_type uchun birlik asl ko'rinishida saqlanadi
+	unit := strings.TrimSpace(raw[len(raw)-len(match[2]):])
+	value, unit, err = convertUnit(dataType, value, unit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Measurement{Value: value, Unit: unit}, nil
+}
@@ -0,0 +1,104 @@
+package mongoDb
+
+import (
+	"math"
+	"testing"
+
+	pb "health/genproto/health_analytics"
+)
+
+func TestConvertUnit(t *testing.T) {
+	tests := []struct {
+		name      string
+		dataType  string
+		value     float64
+		unit      string
+		wantValue float64
+		wantUnit  string
+		wantErr   bool
+	}{
+		{"canonical", "weight", 70, "kg", 70, "kg", false},
+		{"no unit", "weight", 70, "", 70, "kg", false},
+		{"pounds", "weight", 154, "lbs", 69.853225, "kg", false},
+		{"case and spaces", "weight", 1000, " G ", 1, "kg", false},
+		{"fahrenheit", "temperature", 98.6, "°F", 37, "°C", false},
+		{"minutes of sleep", "sleep", 90, "min", 1.5, "h", false},
+		{"mmol/l glucose", "glucose", 5.5, "mmol/L", 99, "mg/dL", false},
+		{"miles", "distance", 1, "mi", 1.609344, "km", false},
+		{"unknown unit", "weight", 70, "stone", 0, "", true},
+		{"unit of other type", "heart_rate", 70, "kg", 0, "", true},
+		{"unknown data type keeps unit", "mood", 7, "points", 7, "points", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, unit, err := convertUnit(tt.dataType, tt.value, tt.unit)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("convertUnit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if math.Abs(value-tt.wantValue) > 1e-6 || unit != tt.wantUnit {
+				t.Errorf("convertUnit() = %v %q, want %v %q", value, unit, tt.wantValue, tt.wantUnit)
+			}
+		})
+	}
+}
+
+func TestNormalizeMeasurement(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataType string
+		raw      string
+		m        *pb.Measurement
+		want     *pb.Measurement
+		wantErr  bool
+	}{
+		{"plain number", "heart_rate", "72", nil, &pb.Measurement{Value: 72, Unit: "bpm"}, false},
+		{"number with unit", "weight", "180 lbs", nil, &pb.Measurement{Value: 81.6466266, Unit: "kg"}, false},
+		{"thousands separator", "steps", "1,200", nil, &pb.Measurement{Value: 1200, Unit: "steps"}, false},
+		{"decimal comma", "temperature", "36,6", nil, &pb.Measurement{Value: 36.6, Unit: "°C"}, false},
+		{"fahrenheit", "temperature", "98.6 °F", nil, &pb.Measurement{Value: 37, Unit: "°C"}, false},
+		{"blood pressure", "blood_pressure", "120/80 mmHg", nil, &pb.Measurement{
+			Value: 120, Unit: "mmHg", Components: map[string]float64{"systolic": 120, "diastolic": 80},
+		}, false},
+		{"bad blood pressure", "blood_pressure", "120", nil, nil, true},
+		{"not a number", "heart_rate", "fast", nil, nil, true},
+		{"unknown unit", "weight", "70 stone", nil, nil, true},
+		{"free text for unknown type", "mood", "good", nil, nil, false},
+		{"number for unknown type", "mood", "7 points", nil, &pb.Measurement{Value: 7, Unit: "points"}, false},
+		{"structured", "weight", "ignored", &pb.Measurement{Value: 2000, Unit: "g"}, &pb.Measurement{Value: 2, Unit: "kg"}, false},
+		{"structured components", "blood_pressure", "", &pb.Measurement{
+			Value: 120, Unit: "mmHg", Components: map[string]float64{"systolic": 120, "diastolic": 80},
+		}, &pb.Measurement{
+			Value: 120, Unit: "mmHg", Components: map[string]float64{"systolic": 120, "diastolic": 80},
+		}, false},
+		{"structured bad unit", "weight", "", &pb.Measurement{Value: 1, Unit: "stone"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeMeasurement(tt.dataType, tt.raw, tt.m)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeMeasurement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !sameMeasurement(got, tt.want) {
+				t.Errorf("normalizeMeasurement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func sameMeasurement(a, b *pb.Measurement) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if math.Abs(a.Value-b.Value) > 1e-6 || a.Unit != b.Unit || len(a.Components) != len(b.Components) {
+		return false
+	}
+	for k, v := range b.Components {
+		if math.Abs(a.Components[k]-v) > 1e-6 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,247 @@
+package mongoDb
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// migrationsCollection qo'llangan migratsiyalar yoziladigan kolleksiya
+const migrationsCollection = "schema_migrations"
+
+// Migration kolleksiyalarni joyida qayta yozadigan bitta versiyalangan qadam.
+// Up qayta ishga tushirilsa ham xavfsiz bo'lishi kerak: yozuv Up muvaffaqiyatli
+// tugagandan keyin qo'yiladi, shuning uchun yarim qolgan migratsiya takrorlanadi.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database, log *slog.Logger) error
+}
+
+// MigrationRecord schema_migrations kolleksiyasidagi hujjat
+type MigrationRecord struct {
+	Version   int       `bson:"version"`
+	Name      string    `bson:"name"`
+	AppliedAt time.Time `bson:"applied_at"`
+}
+
+// Migrations versiya bo'yicha tartiblangan barcha migratsiyalar. Yangi
+// migratsiya faqat oxiriga qo'shiladi, mavjudlarining versiyasi o'zgarmaydi.
+var Migrations = []Migration{
+	{Version: 1, Name: "snake_case_lifestyle_wearable_fields", Up: renameLegacyFields},
+	{Version: 2, Name: "string_deleted_at", Up: normalizeDeletedAt},
+	{Version: 3, Name: "backfill_measurements", Up: backfillMeasurements},
+	{Version: 4, Name: "doctor_consent_indexes", Up: createConsentIndexes},
+	{Version: 5, Name: "audit_log_indexes", Up: createAuditIndexes},
+	{Version: 6, Name: "data_key_indexes", Up: createDataKeyIndexes},
+	{Version: 7, Name: "structured_attachments", Up: structureAttachments},
+	{Version: 8, Name: "wearable_ingest_key", Up: createWearableIngestIndex},
+	{Version: 9, Name: "lifestyle_recorded_date_format", Up: normalizeLifestyleDates},
+	{Version: 10, Name: "recorded_time_indexes", Up: createRecordedIndexes},
+	{Version: 11, Name: "medical_record_date_format", Up: normalizeMedicalRecordDates},
+	{Version: 12, Name: "medical_record_list_indexes", Up: createMedicalRecordListIndexes},
+	{Version: 13, Name: "bson_dates", Up: convertDatesToBSON},
+	{Version: 14, Name: "wearable_natural_ingest_keys", Up: naturalWearableIngestKeys},
+}
+
+// AppliedMigrations qo'llangan migratsiyalarni versiya bo'yicha qaytaradi
+func AppliedMigrations(ctx context.Context, db *mongo.Database) (map[int]MigrationRecord, error) {
+	cursor, err := db.Collection(migrationsCollection).Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var records []MigrationRecord
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]MigrationRecord, len(records))
+	for _, r := range records {
+		applied[r.Version] = r
+	}
+	return applied, nil
+}
+
+// PendingMigrations hali qo'llanmagan migratsiyalarni versiya tartibida qaytaradi
+func PendingMigrations(ctx context.Context, db *mongo.Database) ([]Migration, error) {
+	applied, err := AppliedMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, m := range Migrations {
+		if _, ok := applied[m.Version]; !ok {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Migrate qo'llanmagan migratsiyalarni tartib bilan bajaradi va har birini
+// schema_migrations ga yozadi. Xatolikda to'xtaydi, keyingilari bajarilmaydi.
+func Migrate(ctx context.Context, db *mongo.Database, log *slog.Logger) ([]Migration, error) {
+	records := db.Collection(migrationsCollection)
+	_, err := records.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "version", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s index: %v", migrationsCollection, err)
+	}
+
+	pending, err := PendingMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, m := range pending {
+		log.Info("Applying migration", "version", m.Version, "name", m.Name)
+		if err := m.Up(ctx, db, log); err != nil {
+			return done, fmt.Errorf("migration %d (%s) failed: %v", m.Version, m.Name, err)
+		}
+
+		_, err := records.InsertOne(ctx, MigrationRecord{
+			Version:   m.Version,
+			Name:      m.Name,
+			AppliedAt: now(),
+		})
+		// Parallel ishga tushgan boshqa nusxa allaqachon yozib qo'ygan bo'lishi mumkin
+		if err != nil && !mongo.IsDuplicateKeyError(err) {
+			return done, fmt.Errorf("failed to record migration %d: %v", m.Version, err)
+		}
+		done = append(done, m)
+	}
+	return done, nil
+}
+
+// renameLegacyFields lifestyle_data va wearable_data dagi userid, datatype,
+// createdat kabi maydonlarni medical_records dagidek snake_case ga o'tkazadi
+func renameLegacyFields(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	renames := map[string]bson.M{
+		"lifestyle_data": {
+			"userid":       "user_id",
+			"datatype":     "data_type",
+			"datavalue":    "data_value",
+			"recordeddate": "recorded_date",
+			"createdat":    "created_at",
+			"updatedat":    "updated_at",
+			"deletedat":    "deleted_at",
+		},
+		"wearable_data": {
+			"userid":            "user_id",
+			"devicetype":        "device_type",
+			"datatype":          "data_type",
+			"datavalue":         "data_value",
+			"recordedtimestamp": "recorded_timestamp",
+			"createdat":         "created_at",
+			"updatedat":         "updated_at",
+			"deletedat":         "deleted_at",
+		},
+	}
+
+	for collection, fields := range renames {
+		legacy := make(bson.A, 0, len(fields))
+		for old := range fields {
+			legacy = append(legacy, bson.M{old: bson.M{"$exists": true}})
+		}
+
+		result, err := db.Collection(collection).UpdateMany(ctx, bson.M{"$or": legacy}, bson.M{"$rename": fields})
+		if err != nil {
+			return fmt.Errorf("failed to rename fields in %s: %v", collection, err)
+		}
+		log.Info("Renamed legacy fields", "collection", collection, "modified", result.ModifiedCount)
+	}
+	return nil
+}
+
+// normalizeDeletedAt deleted_at ni hamma joyda satr qiladi: DeleteWearableData
+// avval Unix vaqtini son sifatida yozgan, ayrim hujjatlarda esa maydon yo'q
+func normalizeDeletedAt(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	for _, collection := range []string{"medical_records", "lifestyle_data", "wearable_data"} {
+		coll := db.Collection(collection)
+
+		numeric, err := coll.UpdateMany(ctx,
+			bson.M{"deleted_at": bson.M{"$type": "number"}},
+			mongo.Pipeline{{{Key: "$set", Value: bson.M{
+				"deleted_at": bson.M{"$dateToString": bson.M{
+					"date":   bson.M{"$toDate": bson.M{"$multiply": bson.A{"$deleted_at", 1000}}},
+					"format": "%Y-%m-%dT%H:%M:%SZ",
+				}},
+			}}}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to convert numeric deleted_at in %s: %v", collection, err)
+		}
+
+		missing, err := coll.UpdateMany(ctx,
+			bson.M{"deleted_at": bson.M{"$in": bson.A{nil, ""}}},
+			bson.M{"$set": bson.M{"deleted_at": "0"}},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to set missing deleted_at in %s: %v", collection, err)
+		}
+
+		log.Info("Normalised deleted_at", "collection", collection, "numeric", numeric.ModifiedCount, "missing", missing.ModifiedCount)
+	}
+	return nil
+}
+
+// backfillMeasurements measurement maydoni hali yo'q eski hujjatlarga data_value
+// satridan ajratilgan qiymatni yozadi. Son bo'lmagan qiymatlar uchun measurement
+// null qilib qo'yiladi, shunda ular qayta ko'rib chiqilmaydi.
+func backfillMeasurements(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	for _, collection := range []string{"wearable_data", "lifestyle_data"} {
+		coll := db.Collection(collection)
+
+		cursor, err := coll.Find(ctx, bson.M{"measurement": bson.M{"$exists": false}})
+		if err != nil {
+			return err
+		}
+
+		var models []mongo.WriteModel
+		for cursor.Next(ctx) {
+			var doc struct {
+				Id        string `bson:"id"`
+				DataType  string `bson:"data_type"`
+				DataValue string `bson:"data_value"`
+			}
+			if err := cursor.Decode(&doc); err != nil {
+				log.Warn("Failed to decode document for measurement backfill", "collection", collection, "error", err)
+				continue
+			}
+
+			m, err := normalizeMeasurement(doc.DataType, doc.DataValue, nil)
+			if err != nil {
+				log.Warn("Unparseable data value, leaving measurement empty", "collection", collection, "id", doc.Id, "error", err)
+			}
+
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.M{"_id": cursor.Current.Lookup("_id")}).
+				SetUpdate(bson.M{"$set": bson.M{"measurement": newMeasurementDoc(m)}}))
+		}
+		err = cursor.Err()
+		cursor.Close(ctx)
+		if err != nil {
+			return err
+		}
+		if len(models) == 0 {
+			continue
+		}
+
+		result, err := coll.BulkWrite(ctx, models)
+		if err != nil {
+			return fmt.Errorf("failed to backfill measurements in %s: %v", collection, err)
+		}
+		log.Info("Backfilled measurements", "collection", collection, "modified", result.ModifiedCount)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package mongoDb
+
+import (
+	"time"
+
+	pb "health/genproto/health_analytics"
+)
+
+// medicalRecordDoc medical_records kolleksiyasidagi hujjat ko'rinishi. Vaqtlar
+// BSON Date, o'chirilmagan hujjatlarda deleted_at null.
+type medicalRecordDoc struct {
+	Id          string          `bson:"id"`
+	UserId      string          `bson:"user_id"`
+	RecordType  string          `bson:"record_type"`
+	RecordDate  time.Time       `bson:"record_date"`
+	Description string          `bson:"description"`
+	DoctorId    string          `bson:"doctor_id"`
+	Attachments []attachmentDoc `bson:"attachments"`
+	CreatedAt   time.Time       `bson:"created_at"`
+	UpdatedAt   time.Time       `bson:"updated_at"`
+	DeletedAt   *time.Time      `bson:"deleted_at"`
+	// SearchTokens description bo'yicha qidiruv uchun, qarang searchTokens
+	SearchTokens []string `bson:"search_tokens,omitempty"`
+}
+
+func (d medicalRecordDoc) toProto() *pb.MedicalRecord {
+	attachments := make([]*pb.Attachment, 0, len(d.Attachments))
+	for _, a := range d.Attachments {
+		attachments = append(attachments, a.toProto())
+	}
+	return &pb.MedicalRecord{
+		Id:          d.Id,
+		UserId:      d.UserId,
+		RecordType:  d.RecordType,
+		RecordDate:  protoTime(d.RecordDate),
+		Description: d.Description,
+		DoctorId:    d.DoctorId,
+		Attachments: attachments,
+		CreatedAt:   protoTime(d.CreatedAt),
+		UpdatedAt:   protoTime(d.UpdatedAt),
+		DeletedAt:   protoTimePtr(d.DeletedAt),
+	}
+}
+
+// attachmentDoc tibbiy yozuvdagi fayl ma'lumotlari. Faylning o'zi GridFS ning
+// attachments bucket ida shu id bilan saqlanadi.
+type attachmentDoc struct {
+	Id          string    `bson:"id"`
+	Filename    string    `bson:"filename"`
+	ContentType string    `bson:"content_type"`
+	Size        int64     `bson:"size"`
+	Sha256      string    `bson:"sha256"`
+	UploadedAt  time.Time `bson:"uploaded_at"`
+}
+
+func (d attachmentDoc) toProto() *pb.Attachment {
+	return &pb.Attachment{
+		Id:          d.Id,
+		Filename:    d.Filename,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		Sha256:      d.Sha256,
+		UploadedAt:  protoTime(d.UploadedAt),
+	}
+}
+
+// lifestyleDataDoc lifestyle_data kolleksiyasidagi hujjat ko'rinishi
+type lifestyleDataDoc struct {
+	Id           string          `bson:"id"`
+	UserId       string          `bson:"user_id"`
+	DataType     string          `bson:"data_type"`
+	DataValue    string          `bson:"data_value"`
+	Measurement  *measurementDoc `bson:"measurement"`
+	RecordedDate time.Time       `bson:"recorded_date"`
+	CreatedAt    time.Time       `bson:"created_at"`
+	UpdatedAt    time.Time       `bson:"updated_at"`
+	DeletedAt    *time.Time      `bson:"deleted_at"`
+}
+
+func (d lifestyleDataDoc) toProto() *pb.LifestyleData {
+	return &pb.LifestyleData{
+		Id:           d.Id,
+		UserId:       d.UserId,
+		DataType:     d.DataType,
+		DataValue:    d.DataValue,
+		Measurement:  d.Measurement.toProto(),
+		RecordedDate: protoTime(d.RecordedDate),
+		CreatedAt:    protoTime(d.CreatedAt),
+		UpdatedAt:    protoTime(d.UpdatedAt),
+		DeletedAt:    protoTimePtr(d.DeletedAt),
+	}
+}
+
+// wearableDataDoc wearable_data kolleksiyasidagi hujjat ko'rinishi
+type wearableDataDoc struct {
+	Id                string          `bson:"id"`
+	UserId            string          `bson:"user_id"`
+	DeviceType        string          `bson:"device_type"`
+	DataType          string          `bson:"data_type"`
+	DataValue         string          `bson:"data_value"`
+	Measurement       *measurementDoc `bson:"measurement"`
+	RecordedTimestamp time.Time       `bson:"recorded_timestamp"`
+	CreatedAt         time.Time       `bson:"created_at"`
+	UpdatedAt         time.Time       `bson:"updated_at"`
+	DeletedAt         *time.Time      `bson:"deleted_at"`
+	// IngestKey takror kelgan xabarlarni ajratadi, qarang wearableIngestKey
+	IngestKey string `bson:"ingest_key"`
+}
+
+func (d wearableDataDoc) toProto() *pb.WearableData {
+	return &pb.WearableData{
+		Id:                d.Id,
+		UserId:            d.UserId,
+		DeviceType:        d.DeviceType,
+		DataType:          d.DataType,
+		DataValue:         d.DataValue,
+		Measurement:       d.Measurement.toProto(),
+		RecordedTimestamp: protoTime(d.RecordedTimestamp),
+		CreatedAt:         protoTime(d.CreatedAt),
+		UpdatedAt:         protoTime(d.UpdatedAt),
+		DeletedAt:         protoTimePtr(d.DeletedAt),
+	}
+}
@@ -2,11 +2,13 @@ package mongoDb
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"health/config"
+	"health/pkg/connection"
 )
 
 func NewMongoClient() (*mongo.Client, *mongo.Database, error) {
@@ -18,10 +20,18 @@ func NewMongoClient() (*mongo.Client, *mongo.Database, error) {
 		return nil, nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// Driver uzilgan ulanishlarni o'zi tiklaydi, startda esa MongoDB ko'tarilishini
+	// ConnectTimeout gacha kutamiz
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ConnectTimeout)
 	defer cancel()
 
-	if err := client.Ping(ctx, nil); err != nil {
+	err = connection.Retry(ctx, connection.DefaultBackoff, slog.Default(), "mongodb", func(ctx context.Context) error {
+		pingCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		return client.Ping(pingCtx, nil)
+	})
+	if err != nil {
+		client.Disconnect(context.Background())
 		return nil, nil, err
 	}
 
@@ -2,16 +2,24 @@ package mongoDb
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	logger "health/pkg"
 	"log/slog"
 
+	"health/config"
+	authpb "health/genproto/auth"
+	"health/pkg/auth"
+	"health/pkg/connection"
+	"health/pkg/envelope"
+
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	pb "health/genproto/health_analytics"
 
@@ -27,88 +35,155 @@ type Health struct {
 	Logger          *slog.Logger
 	Db              *mongo.Database
 	Redis           *redis.Client
-	RabbitMQChannel *amqp.Channel
+	MQ              *connection.RabbitMQ
+	Topology        config.Topology
+	Rules           []RecommendationRule
+	Auth            authpb.AuthServiceClient
+	// Keys tibbiy yozuvlarni shifrlash uchun master key lar, nil bo'lsa
+	// shifrlash o'chirilgan
+	Keys *envelope.MasterKeys
+	// AttachmentMaxBytes bitta yuklanadigan fayl hajmi chegarasi
+	AttachmentMaxBytes int64
+
+	// publishMu RabbitMQ kanaliga confirm rejimida yozishni ketma-ket qiladi.
+	// confirmCh confirm rejimiga o'tkazilgan kanal, qayta ulanishdan keyin
+	// yangi kanal ham confirm rejimiga o'tkaziladi.
+	publishMu  sync.Mutex
+	confirmCh  *amqp.Channel
+	confirms   chan amqp.Confirmation
+	publishSeq uint64
+
+	// auditMu audit_log zanjiriga yozishni ketma-ket qiladi
+	auditMu sync.Mutex
+
+	// dataKeys ochilgan data key lar keshi, kalit "user_id/version"
+	dataKeys sync.Map
 }
 
-func NewHealth(mdb *mongo.Database, rdb *redis.Client, amqpChannel *amqp.Channel) *Health {
+func NewHealth(mdb *mongo.Database, rdb *redis.Client, mq *connection.RabbitMQ) *Health {
 	return &Health{
 		Logger:          logger.NewLogger(),
 		Db:              mdb,
 		Redis:           rdb,
-		RabbitMQChannel: amqpChannel,
+		MQ:              mq,
+		Topology:        config.DefaultTopology(),
+		Rules:           DefaultRecommendationRules,
+
+		AttachmentMaxBytes: DefaultAttachmentMaxBytes,
 	}
 }
 
 func (h *Health) AddMedicalRecord(ctx context.Context, req *pb.AddMedicalRecordRequest) (*pb.AddMedicalRecordResponse, error) {
+	// Interceptor bo'sh user_id ni tekshirmaydi, egasiz yozuvni esa hech kim o'qiy olmaydi
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id bo'sh bo'lmasligi kerak")
+	}
 	// Yangi UUID yaratish
 	id := uuid.New().String()
-	createdAt := time.Now().Format(time.RFC3339)
-	updatedAt := time.Now().Format(time.RFC3339)
-
-	recordBson := bson.M{
-		"id":          id,
-		"user_id":     req.UserId,
-		"record_type": req.RecordType,
-		"record_date": req.RecordDate,
-		"description": req.Description,
-		"doctor_id":   req.DoctorId,
-		"attachments": req.Attachments,
-		"created_at":  createdAt,
-		"updated_at":  updatedAt,
-		"deleted_at":  "0",
-	}
-
-	_, err := h.Db.Collection("medical_records").InsertOne(ctx, recordBson)
+	recordDate, err := requiredTime(req.RecordDate, "record_date")
 	if err != nil {
-		h.Logger.Error("Failed to add medical record", "error", err)
 		return nil, err
 	}
+	vaqt := now()
 
-	medicalRecord := &pb.MedicalRecord{
+	record := medicalRecordDoc{
 		Id:          id,
 		UserId:      req.UserId,
 		RecordType:  req.RecordType,
-		RecordDate:  req.RecordDate,
+		RecordDate:  recordDate,
 		Description: req.Description,
 		DoctorId:    req.DoctorId,
-		Attachments: req.Attachments,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
+		Attachments: []attachmentDoc{},
+		CreatedAt:   vaqt,
+		UpdatedAt:   vaqt,
 	}
 
-	return &pb.AddMedicalRecordResponse{MedicalRecord: medicalRecord}, nil
+	// Bazaga shifrlangan nusxa yoziladi, javobda ochiq matn qaytadi
+	description, err := h.encryptField(ctx, req.UserId, "description", req.Description)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := h.searchTokens(ctx, req.UserId, req.Description)
+	if err != nil {
+		h.Logger.Error("Failed to build search tokens", "error", err)
+		return nil, err
+	}
+	stored := record
+	stored.Description = description
+	stored.SearchTokens = tokens
+
+	_, err = h.Db.Collection("medical_records").InsertOne(ctx, stored)
+	if err != nil {
+		h.Logger.Error("Failed to add medical record", "error", err)
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditCreate, "medical_records", id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddMedicalRecordResponse{MedicalRecord: record.toProto()}, nil
 }
 
 func (h *Health) GetMedicalRecord(ctx context.Context, req *pb.GetMedicalRecordRequest) (*pb.GetMedicalRecordResponse, error) {
-	var record pb.MedicalRecord
-	err := h.Db.Collection("medical_records").FindOne(ctx, bson.M{"$and": []bson.M{{"id": req.Id}, {"deleted_at": "0"}}}).Decode(&record)
+	var record medicalRecordDoc
+	err := h.Db.Collection("medical_records").FindOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}).Decode(&record)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			h.Logger.Warn("Medical record not found", "record_id", req.Id)
-			return nil, errors.New("tibbiy yozuv topilmadi")
+			return nil, status.Error(codes.NotFound, "tibbiy yozuv topilmadi")
 		}
 		h.Logger.Error("Failed to get medical record", "error", err)
 		return nil, err
 	}
 
-	return &pb.GetMedicalRecordResponse{MedicalRecord: &record}, nil
+	if err := authorizeRead(ctx, record.UserId, "tibbiy yozuv topilmadi"); err != nil {
+		return nil, err
+	}
+
+	if err := h.decryptRecord(ctx, &record); err != nil {
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditGet, "medical_records", req.Id, record.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetMedicalRecordResponse{MedicalRecord: record.toProto()}, nil
 }
 
 func (h *Health) UpdateMedicalRecord(ctx context.Context, req *pb.UpdateMedicalRecordRequest) (*pb.UpdateMedicalRecordResponse, error) {
-	updatedAt := time.Now().Format(time.RFC3339)
+	owner, err := h.authorizeOwner(ctx, "medical_records", req.Id, "tibbiy yozuv topilmadi")
+	if err != nil {
+		return nil, err
+	}
+	recordDate, err := requiredTime(req.RecordDate, "record_date")
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := h.encryptField(ctx, owner, "description", req.Description)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := h.searchTokens(ctx, owner, req.Description)
+	if err != nil {
+		h.Logger.Error("Failed to build search tokens", "error", err)
+		return nil, err
+	}
 
 	update := bson.M{
 		"$set": bson.M{
-			"record_type": req.RecordType,
-			"record_date": req.RecordDate,
-			"description": req.Description,
-			"doctor_id":   req.DoctorId,
-			"attachments": req.Attachments,
-			"updated_at":  updatedAt,
+			"record_type":   req.RecordType,
+			"record_date":   recordDate,
+			"description":   description,
+			"search_tokens": tokens,
+			"doctor_id":     req.DoctorId,
+			"updated_at":    now(),
 		},
 	}
 
-	result, err := h.Db.Collection("medical_records").UpdateOne(ctx, bson.M{"$and": []bson.M{{"id": req.Id}, {"deleted_at": "0"}}}, update)
+	result, err := h.Db.Collection("medical_records").UpdateOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}, update)
 	if err != nil {
 		h.Logger.Error("Failed to update medical record", "error", err)
 		return nil, err
@@ -119,11 +194,20 @@ func (h *Health) UpdateMedicalRecord(ctx context.Context, req *pb.UpdateMedicalR
 		return &pb.UpdateMedicalRecordResponse{Success: false}, errors.New("tibbiy yozuv topilmadi")
 	}
 
+	if err := h.audit(ctx, auditUpdate, "medical_records", req.Id, owner); err != nil {
+		return nil, err
+	}
+
 	return &pb.UpdateMedicalRecordResponse{Success: true}, nil
 }
 
 func (h *Health) DeleteMedicalRecord(ctx context.Context, req *pb.DeleteMedicalRecordRequest) (*pb.DeleteMedicalRecordResponse, error) {
-	currentTime := time.Now().Format(time.RFC3339)
+	owner, err := h.authorizeOwner(ctx, "medical_records", req.Id, "tibbiy yozuv topilmadi")
+	if err != nil {
+		return nil, err
+	}
+
+	currentTime := now()
 
 	result, err := h.Db.Collection("medical_records").UpdateOne(
 		ctx,
@@ -140,140 +224,215 @@ func (h *Health) DeleteMedicalRecord(ctx context.Context, req *pb.DeleteMedicalR
 		return &pb.DeleteMedicalRecordResponse{Success: false}, errors.New("tibbiy yozuv topilmadi")
 	}
 
+	if err := h.audit(ctx, auditDelete, "medical_records", req.Id, owner); err != nil {
+		return nil, err
+	}
+
 	return &pb.DeleteMedicalRecordResponse{Success: true}, nil
 }
 
+// ListMedicalRecords tibbiy yozuvlarni filtrlab, saralab va cursor bilan
+// sahifalab qaytaradi
 func (h *Health) ListMedicalRecords(ctx context.Context, req *pb.ListMedicalRecordsRequest) (*pb.ListMedicalRecordsResponse, error) {
-	cursor, err := h.Db.Collection("medical_records").Find(ctx, bson.M{"$and": []bson.M{{"user_id": req.UserId}, {"deleted_at": "0"}}}, options.Find())
+	filter := listFilter(ctx, req.UserId, "")
+	if req.RecordType != "" {
+		filter["record_type"] = req.RecordType
+	}
+	if req.DoctorId != "" {
+		filter["doctor_id"] = req.DoctorId
+	}
+	if err := timeRange(filter, "record_date", req.From, req.To); err != nil {
+		return nil, err
+	}
+
+	if req.Query != "" {
+		words := searchWords(req.Query)
+		if len(words) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "query da kamida %d harfli so'z bo'lishi kerak", minSearchWordLen)
+		}
+		userID, _ := filter["user_id"].(string)
+		if userID == "" {
+			return nil, status.Error(codes.InvalidArgument, "query bilan qidirish uchun user_id kiritilishi shart")
+		}
+		search, err := h.searchFilter(ctx, userID, words)
+		if err != nil {
+			h.Logger.Error("Failed to build search filter", "user_id", userID, "error", err)
+			return nil, err
+		}
+		filter["$or"] = search["$or"]
+	}
+
+	sortField := "record_date"
+	if req.SortBy == pb.MedicalRecordSortField_MEDICAL_RECORD_SORT_FIELD_CREATED_AT {
+		sortField = "created_at"
+	}
+
+	docs, next, total, err := listRecorded[medicalRecordDoc](ctx, h.Db.Collection("medical_records"), recordedQuery{
+		Filter:    filter,
+		TimeField: sortField,
+		Order:     req.SortOrder,
+		Limit:     req.Limit,
+		Cursor:    req.Cursor,
+	})
 	if err != nil {
 		h.Logger.Error("Failed to list medical records", "error", err)
 		return nil, err
 	}
-	defer func() {
-		if cursor != nil {
-			cursor.Close(ctx)
-		}
-	}()
-
-	var records []*pb.MedicalRecord
-	for cursor.Next(ctx) {
-		var record pb.MedicalRecord
-		if err := cursor.Decode(&record); err != nil {
-			h.Logger.Warn("Failed to decode medical record", "error", err)
-			continue
+
+	records := make([]*pb.MedicalRecord, 0, len(docs))
+	for i := range docs {
+		if err := h.decryptRecord(ctx, &docs[i]); err != nil {
+			return nil, err
 		}
-		records = append(records, &record)
+		records = append(records, docs[i].toProto())
 	}
 
-	if err := cursor.Err(); err != nil {
-		h.Logger.Error("Cursor error while listing medical records", "error", err)
+	if err := h.audit(ctx, auditList, "medical_records", "", req.UserId); err != nil {
 		return nil, err
 	}
 
-	return &pb.ListMedicalRecordsResponse{MedicalRecords: records}, nil
+	return &pb.ListMedicalRecordsResponse{
+		MedicalRecords: records,
+		NextCursor:     next,
+		TotalCount:     total,
+	}, nil
 }
 
 // AddLifestyleData yangi turmush tarzi ma'lumotlarini qo'shadi
 func (h *Health) AddLifestyleData(ctx context.Context, req *pb.AddLifestyleDataRequest) (*pb.AddLifestyleDataResponse, error) {
-
-	vaqt := time.Now().Format(time.RFC3339)
-	id := uuid.NewString()
-
-	lifestyleData := bson.M{
-		"id":           id,
-		"userid":       req.UserId,
-		"datatype":     req.DataType,
-		"datavalue":    req.DataValue,
-		"recordeddate": req.RecordedDate,
-		"createdat":    vaqt,
-		"updatedat":    vaqt,
-		"deletedat":    "0",
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id bo'sh bo'lmasligi kerak")
+	}
+	measurement, err := normalizeMeasurement(req.DataType, req.DataValue, req.Measurement)
+	if err != nil {
+		h.Logger.Warn("Invalid lifestyle data value", "error", err)
+		return nil, err
 	}
 
-	_, err := h.Db.Collection("lifestyle_data").InsertOne(ctx, lifestyleData)
+	recordedDate, err := requiredTime(req.RecordedDate, "recorded_date")
 	if err != nil {
-		h.Logger.Error("Failed to add lifestyle data", "error", err)
 		return nil, err
 	}
+	vaqt := now()
+	id := uuid.NewString()
 
-	return &pb.AddLifestyleDataResponse{LifestyleData: &pb.LifestyleData{
+	lifestyleData := lifestyleDataDoc{
 		Id:           id,
 		UserId:       req.UserId,
 		DataType:     req.DataType,
 		DataValue:    req.DataValue,
-		RecordedDate: req.RecordedDate,
+		Measurement:  newMeasurementDoc(measurement),
+		RecordedDate: recordedDate,
 		CreatedAt:    vaqt,
 		UpdatedAt:    vaqt,
-	}}, nil
-}
+	}
 
-// GetAllLifestyleData metodi
-func (h *Health) GetAllLifestyleData(ctx context.Context, req *pb.GetAllLifestyleDataRequest) (*pb.GetAllLifestyleDataResponse, error) {
-	collection := h.Db.Collection("lifestyle_data")
+	_, err = h.Db.Collection("lifestyle_data").InsertOne(ctx, lifestyleData)
+	if err != nil {
+		h.Logger.Error("Failed to add lifestyle data", "error", err)
+		return nil, err
+	}
 
-	skip := (req.Page - 1) * req.Limit
+	if err := h.audit(ctx, auditCreate, "lifestyle_data", id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddLifestyleDataResponse{LifestyleData: lifestyleData.toProto()}, nil
+}
 
-	findOptions := options.Find()
-	findOptions.SetLimit(req.GetLimit())
-	findOptions.SetSkip(skip)
+// GetAllLifestyleData turmush tarzi ma'lumotlarini filtrlab, recorded_date
+// bo'yicha saralab va cursor bilan sahifalab qaytaradi
+func (h *Health) GetAllLifestyleData(ctx context.Context, req *pb.GetAllLifestyleDataRequest) (*pb.GetAllLifestyleDataResponse, error) {
+	filter := listFilter(ctx, req.UserId, req.DataType)
+	if err := timeRange(filter, "recorded_date", req.From, req.To); err != nil {
+		return nil, err
+	}
 
-	cursor, err := collection.Find(ctx, bson.M{}, findOptions)
+	docs, next, total, err := listRecorded[lifestyleDataDoc](ctx, h.Db.Collection("lifestyle_data"), recordedQuery{
+		Filter:    filter,
+		TimeField: "recorded_date",
+		Order:     req.SortOrder,
+		Limit:     req.Limit,
+		Cursor:    req.Cursor,
+	})
 	if err != nil {
 		h.Logger.Error("Error finding lifestyle data", "error", err)
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var lifestyleDataList []*pb.LifestyleData
-	for cursor.Next(ctx) {
-		var data pb.LifestyleData
-		if err := cursor.Decode(&data); err != nil {
-			h.Logger.Error("Error decoding lifestyle data", "error", err)
-			return nil, err
-		}
-		lifestyleDataList = append(lifestyleDataList, &data)
+	lifestyleDataList := make([]*pb.LifestyleData, 0, len(docs))
+	for _, d := range docs {
+		lifestyleDataList = append(lifestyleDataList, d.toProto())
 	}
 
-	if err := cursor.Err(); err != nil {
-		h.Logger.Error("Cursor error", "error", err)
+	if err := h.audit(ctx, auditList, "lifestyle_data", "", req.UserId); err != nil {
 		return nil, err
 	}
 
 	return &pb.GetAllLifestyleDataResponse{
 		Lifestyledata: lifestyleDataList,
+		NextCursor:    next,
+		TotalCount:    total,
 	}, nil
 }
 
 // GetLifestyleData turmush tarzi ma'lumotlarini olish uchun
 func (h *Health) GetLifestyleData(ctx context.Context, req *pb.GetLifestyleDataRequest) (*pb.GetLifestyleDataResponse, error) {
-	var lifestyleData pb.LifestyleData
+	var lifestyleData lifestyleDataDoc
 
-	err := h.Db.Collection("lifestyle_data").FindOne(ctx, bson.M{"$and": []bson.M{{"id": req.Id}, {"deletedat": "0"}}}).Decode(&lifestyleData)
+	err := h.Db.Collection("lifestyle_data").FindOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}).Decode(&lifestyleData)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			h.Logger.Warn("Lifestyle data not found", "id", req.Id)
-			return nil, errors.New("turmush tarzi ma'lumotlari topilmadi")
+			return nil, status.Error(codes.NotFound, "turmush tarzi ma'lumotlari topilmadi")
 		}
 		h.Logger.Error("Failed to get lifestyle data", "error", err)
 		return nil, err
 	}
 
-	return &pb.GetLifestyleDataResponse{LifestyleData: &lifestyleData}, nil
+	if err := authorizeRead(ctx, lifestyleData.UserId, "turmush tarzi ma'lumotlari topilmadi"); err != nil {
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditGet, "lifestyle_data", req.Id, lifestyleData.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetLifestyleDataResponse{LifestyleData: lifestyleData.toProto()}, nil
 }
 
 // UpdateLifestyleData turmush tarzi ma'lumotlarini yangilash uchun
 func (h *Health) UpdateLifestyleData(ctx context.Context, req *pb.UpdateLifestyleDataRequest) (*pb.UpdateLifestyleDataResponse, error) {
+	owner, err := h.authorizeOwner(ctx, "lifestyle_data", req.Id, "turmush tarzi ma'lumotlari topilmadi")
+	if err != nil {
+		return nil, err
+	}
+	// Yozuv boshqa foydalanuvchiga o'tkazilmaydi
+	if req.UserId != "" && req.UserId != owner {
+		return nil, status.Error(codes.InvalidArgument, "user_id ni o'zgartirib bo'lmaydi")
+	}
+
+	measurement, err := normalizeMeasurement(req.DataType, req.DataValue, req.Measurement)
+	if err != nil {
+		h.Logger.Warn("Invalid lifestyle data value", "id", req.Id, "error", err)
+		return nil, err
+	}
+	recordedDate, err := requiredTime(req.RecordedDate, "recorded_date")
+	if err != nil {
+		return nil, err
+	}
+
 	update := bson.M{
 		"$set": bson.M{
-			"userid":       req.UserId,
-			"datatype":     req.DataType,
-			"datavalue":    req.DataValue,
-			"recordeddate": req.RecordedDate,
-			"updatedat":    time.Now().Format(time.RFC3339),
+			"data_type":     req.DataType,
+			"data_value":    req.DataValue,
+			"measurement":   newMeasurementDoc(measurement),
+			"recorded_date": recordedDate,
+			"updated_at":    now(),
 		},
 	}
 
-	result, err := h.Db.Collection("lifestyle_data").UpdateOne(ctx, bson.M{"$and": []bson.M{{"id": req.Id}, {"deletedat": "0"}}}, update)
+	result, err := h.Db.Collection("lifestyle_data").UpdateOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}, update)
 	if err != nil {
 		h.Logger.Error("Failed to update lifestyle data", "error", err)
 		return nil, err
@@ -284,19 +443,28 @@ func (h *Health) UpdateLifestyleData(ctx context.Context, req *pb.UpdateLifestyl
 		return &pb.UpdateLifestyleDataResponse{Success: false}, errors.New("turmush tarzi ma'lumotlari topilmadi")
 	}
 
+	if err := h.audit(ctx, auditUpdate, "lifestyle_data", req.Id, owner); err != nil {
+		return nil, err
+	}
+
 	return &pb.UpdateLifestyleDataResponse{Success: true}, nil
 }
 
 // DeleteLifestyleData turmush tarzi ma'lumotlarini o'chirish uchun
 func (h *Health) DeleteLifestyleData(ctx context.Context, req *pb.DeleteLifestyleDataRequest) (*pb.DeleteLifestyleDataResponse, error) {
+	owner, err := h.authorizeOwner(ctx, "lifestyle_data", req.Id, "turmush tarzi ma'lumotlari topilmadi")
+	if err != nil {
+		return nil, err
+	}
+
 	// Hozirgi vaqtni olish
-	currentTime := time.Now().Format(time.RFC3339)
+	currentTime := now()
 
 	// Turmush tarzi ma'lumotlarini yangilash
 	result, err := h.Db.Collection("lifestyle_data").UpdateOne(
 		ctx,
 		bson.M{"id": req.Id},
-		bson.M{"$set": bson.M{"deletedat": currentTime}},
+		bson.M{"$set": bson.M{"deleted_at": currentTime}},
 	)
 	if err != nil {
 		h.Logger.Error("Failed to delete lifestyle data", "error", err)
@@ -309,6 +477,10 @@ func (h *Health) DeleteLifestyleData(ctx context.Context, req *pb.DeleteLifestyl
 		return &pb.DeleteLifestyleDataResponse{Success: false}, errors.New("turmush tarzi ma'lumotlari topilmadi")
 	}
 
+	if err := h.audit(ctx, auditDelete, "lifestyle_data", req.Id, owner); err != nil {
+		return nil, err
+	}
+
 	return &pb.DeleteLifestyleDataResponse{Success: true}, nil
 }
 
@@ -361,74 +533,110 @@ func (h *Health) DeleteLifestyleData(ctx context.Context, req *pb.DeleteLifestyl
 // 	}
 // }
 
-// GetAllWearableData metodi
+// GetAllWearableData kiyiladigan qurilma ma'lumotlarini filtrlab,
+// recorded_timestamp bo'yicha saralab va cursor bilan sahifalab qaytaradi
 func (h *Health) GetAllWearableData(ctx context.Context, req *pb.GetAllWearableDataRequest) (*pb.GetAllWearableDataResponse, error) {
-	collection := h.Db.Collection("wearable_data")
-
-	skip := (req.GetPage() - 1) * req.GetLimit()
-
-	findOptions := options.Find()
-	findOptions.SetLimit(req.GetLimit())
-	findOptions.SetSkip(skip)
+	filter := listFilter(ctx, req.UserId, req.DataType)
+	if req.DeviceType != "" {
+		filter["device_type"] = req.DeviceType
+	}
+	if err := timeRange(filter, "recorded_timestamp", req.From, req.To); err != nil {
+		return nil, err
+	}
 
-	cursor, err := collection.Find(ctx, bson.M{}, findOptions)
+	docs, next, total, err := listRecorded[wearableDataDoc](ctx, h.Db.Collection("wearable_data"), recordedQuery{
+		Filter:    filter,
+		TimeField: "recorded_timestamp",
+		Order:     req.SortOrder,
+		Limit:     req.Limit,
+		Cursor:    req.Cursor,
+	})
 	if err != nil {
 		h.Logger.Error("Error finding wearable data", "error", err)
 		return nil, err
 	}
-	defer cursor.Close(ctx)
 
-	var wearableDataList []*pb.WearableData
-	for cursor.Next(ctx) {
-		var data pb.WearableData
-		if err := cursor.Decode(&data); err != nil {
-			h.Logger.Error("Error decoding wearable data", "error", err)
-			return nil, err
-		}
-		wearableDataList = append(wearableDataList, &data)
+	wearableDataList := make([]*pb.WearableData, 0, len(docs))
+	for _, d := range docs {
+		wearableDataList = append(wearableDataList, d.toProto())
 	}
 
-	if err := cursor.Err(); err != nil {
-		h.Logger.Error("Cursor error", "error", err)
+	if err := h.audit(ctx, auditList, "wearable_data", "", req.UserId); err != nil {
 		return nil, err
 	}
 
 	return &pb.GetAllWearableDataResponse{
 		Wearabledata: wearableDataList,
+		NextCursor:   next,
+		TotalCount:   total,
 	}, nil
 }
 
 // GetWearableData kiyiladigan qurilma ma'lumotlarini olish uchun
 func (h *Health) GetWearableData(ctx context.Context, req *pb.GetWearableDataRequest) (*pb.GetWearableDataResponse, error) {
-	var wearableData pb.WearableData
+	var wearableData wearableDataDoc
 
-	err := h.Db.Collection("wearable_data").FindOne(ctx, bson.M{"$and": []bson.M{{"id": req.Id}, {"deletedat": "0"}}}).Decode(&wearableData)
+	err := h.Db.Collection("wearable_data").FindOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}).Decode(&wearableData)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
 			h.Logger.Warn("Wearable data not found", "id", req.Id)
-			return nil, errors.New("kiyiladigan qurilma ma'lumotlari topilmadi")
+			return nil, status.Error(codes.NotFound, "kiyiladigan qurilma ma'lumotlari topilmadi")
 		}
 		h.Logger.Error("Failed to get wearable data", "error", err)
 		return nil, err
 	}
 
-	return &pb.GetWearableDataResponse{WearableData: &wearableData}, nil
+	if err := authorizeRead(ctx, wearableData.UserId, "kiyiladigan qurilma ma'lumotlari topilmadi"); err != nil {
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditGet, "wearable_data", req.Id, wearableData.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetWearableDataResponse{WearableData: wearableData.toProto()}, nil
 }
 
 // UpdateWearableData kiyiladigan qurilma ma'lumotlarini yangilash uchun
 func (h *Health) UpdateWearableData(ctx context.Context, req *pb.UpdateWearableDataRequest) (*pb.UpdateWearableDataResponse, error) {
+	owner, err := h.authorizeOwner(ctx, "wearable_data", req.Id, "kiyiladigan qurilma ma'lumotlari topilmadi")
+	if err != nil {
+		return nil, err
+	}
+	// Yozuv boshqa foydalanuvchiga o'tkazilmaydi
+	if req.UserId != "" && req.UserId != owner {
+		return nil, status.Error(codes.InvalidArgument, "user_id ni o'zgartirib bo'lmaydi")
+	}
+
+	measurement, err := normalizeMeasurement(req.DataType, req.DataValue, req.Measurement)
+	if err != nil {
+		h.Logger.Warn("Invalid wearable data value", "id", req.Id, "error", err)
+		return nil, err
+	}
+	recordedTimestamp, err := requiredTime(req.RecordedTimestamp, "recorded_timestamp")
+	if err != nil {
+		return nil, err
+	}
+
+	// ingest_key o'lchov bilan birga o'zgaradi: eski kalit qolsa, shu qiymatlar
+	// bilan kelgan yangi o'lchov takror deb tashlab yuboriladi
 	update := bson.M{
 		"$set": bson.M{
-			"userid":            req.UserId,
-			"devicetype":        req.DeviceType,
-			"datatype":          req.DataType,
-			"datavalue":         req.DataValue,
-			"recordedtimestamp": req.RecordedTimestamp,
-			"updatedat":         time.Now().Format(time.RFC3339),
+			"device_type":        req.DeviceType,
+			"data_type":          req.DataType,
+			"data_value":         req.DataValue,
+			"measurement":        newMeasurementDoc(measurement),
+			"recorded_timestamp": recordedTimestamp,
+			"ingest_key":         wearableIngestKey(owner, req.DeviceType, req.DataType, recordedTimestamp),
+			"updated_at":         now(),
 		},
 	}
 
-	result, err := h.Db.Collection("wearable_data").UpdateOne(ctx, bson.M{"$and": []bson.M{{"id": req.Id}, {"deletedat": "0"}}}, update)
+	result, err := h.Db.Collection("wearable_data").UpdateOne(ctx, bson.M{"id": req.Id, "deleted_at": nil}, update)
+	if mongo.IsDuplicateKeyError(err) {
+		h.Logger.Warn("Wearable data update collides with another reading", "id", req.Id)
+		return nil, status.Error(codes.AlreadyExists, "bu qurilmadan shu vaqtdagi o'lchov allaqachon mavjud")
+	}
 	if err != nil {
 		h.Logger.Error("Failed to update wearable data", "error", err)
 		return nil, err
@@ -439,19 +647,28 @@ func (h *Health) UpdateWearableData(ctx context.Context, req *pb.UpdateWearableD
 		return &pb.UpdateWearableDataResponse{Success: false}, errors.New("kiyiladigan qurilma ma'lumotlari topilmadi")
 	}
 
+	if err := h.audit(ctx, auditUpdate, "wearable_data", req.Id, owner); err != nil {
+		return nil, err
+	}
+
 	return &pb.UpdateWearableDataResponse{Success: true}, nil
 }
 
 // DeleteWearableData kiyiladigan qurilma ma'lumotlarini o'chirish uchun
 func (h *Health) DeleteWearableData(ctx context.Context, req *pb.DeleteWearableDataRequest) (*pb.DeleteWearableDataResponse, error) {
+	owner, err := h.authorizeOwner(ctx, "wearable_data", req.Id, "kiyiladigan qurilma ma'lumotlari topilmadi")
+	if err != nil {
+		return nil, err
+	}
+
 	// Hozirgi vaqtni olish
-	currentTime := time.Now().Unix()
+	currentTime := now()
 
 	// Kiyiladigan qurilma ma'lumotlarini yangilash
 	result, err := h.Db.Collection("wearable_data").UpdateOne(
 		ctx,
 		bson.M{"id": req.Id},
-		bson.M{"$set": bson.M{"deletedat": currentTime}},
+		bson.M{"$set": bson.M{"deleted_at": currentTime}},
 	)
 	if err != nil {
 		h.Logger.Error("Failed to delete wearable data", "error", err)
@@ -464,76 +681,40 @@ func (h *Health) DeleteWearableData(ctx context.Context, req *pb.DeleteWearableD
 		return &pb.DeleteWearableDataResponse{Success: false}, errors.New("kiyiladigan qurilma ma'lumotlari topilmadi")
 	}
 
+	if err := h.audit(ctx, auditDelete, "wearable_data", req.Id, owner); err != nil {
+		return nil, err
+	}
+
 	return &pb.DeleteWearableDataResponse{Success: true}, nil
 }
 
-// func (h *Health) GenerateHealthRecommendations(ctx context.Context, req *pb.GenerateHealthRecommendationsRequest) (*pb.GenerateHealthRecommendationsResponse, error) {
-// 	coll := h.Db.Collection("health")
-
-// 	id := uuid.NewString()
-// 	date := time.Now().Format("2006/01/02")
-
-// 	_, err := coll.InsertOne(ctx, bson.M{
-// 		"id":                  id,
-// 		"user_id":             req.UserId,
-// 		"recommendation_type": req.RecommendationType,
-// 		"description":         req.Description,
-// 		"priority":            req.Priority,
-// 		"created_at":          date,
-// 		"updated_at":          date,
-// 		"deleted_at":          "0",
-// 	})
-
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	recod := pb.HealthRecommendation{
-// 		Id:                 id,
-// 		UserId:             req.UserId,
-// 		RecommendationType: req.RecommendationType,
-// 		Description:        req.Description,
-// 		Priority:           req.Priority,
-// 		CreatedAt:          date,
-// 		UpdatedAt:          date,
-// 	}
-
-// 	// Redisga yozish
-// 	redisKey := req.UserId
-// 	redisValue, err := json.Marshal(recod)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	err = h.Redis.Set(ctx, redisKey, redisValue, 0).Err()
-// 	if err != nil {
-// 		return nil, err
-// 	}
-
-// 	return &pb.GenerateHealthRecommendationsResponse{
-// 		Recommendations: &recod,
-// 	}, nil
-// }
-
 func (h *Health) GenerateHealthRecommendationsId(ctx context.Context, req *pb.GenerateHealthRecommendationsIdRequest) (*pb.GenerateHealthRecommendationsIdResponse, error) {
 	collection := h.Db.Collection("health")
 
-	filter := bson.M{"id": req.Id, "deleted_at": "0"}
+	filter := bson.M{"id": req.Id, "deleted_at": nil}
 
-	var recommendation pb.HealthRecommendation
+	var recommendation healthRecommendationDoc
 
 	err := collection.FindOne(ctx, filter).Decode(&recommendation)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			h.Logger.Error("Bunday id yoki deleted_at '0' bo'lgan hujjat topilmadi")
-			return nil, fmt.Errorf(codes.NotFound.String(), "Bunday id yoki deleted_at '0' bo'lgan hujjat topilmadi")
+			h.Logger.Error("Bunday id li o'chirilmagan hujjat topilmadi")
+			return nil, fmt.Errorf(codes.NotFound.String(), "Bunday id li o'chirilmagan hujjat topilmadi")
 		}
 		h.Logger.Error("MongoDB dan hujjat olishda xatolik", "error", err)
 		return nil, fmt.Errorf(codes.Internal.String(), "Hujjatni olishda xatolik: %v", err)
 	}
 
+	if err := auth.Authorize(ctx, recommendation.UserId); err != nil {
+		return nil, err
+	}
+
 	response := &pb.GenerateHealthRecommendationsIdResponse{
-		Recommendations: &recommendation,
+		Recommendations: recommendation.toProto(),
+	}
+
+	if err := h.audit(ctx, auditGet, "health", req.Id, recommendation.UserId); err != nil {
+		return nil, err
 	}
 
 	return response, nil
@@ -556,13 +737,14 @@ func (h *Health) GetRealtimeHealthMonitoring(ctx context.Context, req *pb.GetRea
 		return nil, fmt.Errorf("failed to get Redis data: %v", err)
 	}
 
-	err = json.Unmarshal([]byte(val), &user)
+	err = protojson.Unmarshal([]byte(val), &user)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal Redis data: %v", err)
 	}
 
-	date := time.Now().Format("2006/01/02")
-	if user.CreatedAt != date {
+	// Tavsiya foydalanuvchining bugungi kunida yaratilgan bo'lishi kerak
+	loc := h.location(ctx, req.UserId)
+	if !startOfDay(user.CreatedAt.AsTime(), loc).Equal(startOfDay(time.Now(), loc)) {
 		return nil, fmt.Errorf("no health data found for today's date")
 	}
 
@@ -572,88 +754,76 @@ func (h *Health) GetRealtimeHealthMonitoring(ctx context.Context, req *pb.GetRea
 		Priority:           user.Priority,
 	}
 
-	return &resp, nil
-}
-
-
-func (h *Health) UserIDHealth(ctx context.Context, req *pb.GetRealtimeHealthMonitoringRequest) (*pb.GetRealtimeHealthMonitoringResponse, error){
-	var user pb.HealthRecommendation
-
-	collection := h.Db.Collection("health")
-
-	filter := bson.M{"$and": []bson.M{{"user_id": req.UserId}, {"deleted_at": "0"}}}
-
-	err := collection.FindOne(ctx, filter).Decode(&user)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("no health data found for user with ID: %v", req.UserId)
-		}
-		return nil, fmt.Errorf("failed to fetch data from MongoDB: %v", err)
-	}
-
-	resp := pb.GetRealtimeHealthMonitoringResponse{
-		RecommendationType: user.RecommendationType,
-		Description:        user.Description,
-		Priority:           user.Priority,
+	if err := h.audit(ctx, auditGet, "health", "", req.UserId); err != nil {
+		return nil, err
 	}
 
 	return &resp, nil
 }
 
-func (h *Health) GetDailyHealthSummary(ctx context.Context, req *pb.GetDailyHealthSummaryRequest) (*pb.GetDailyHealthSummaryResponse, error) {
-	var summary pb.GetDailyHealthSummaryResponse
-	coll := h.Db.Collection("health")
-
-	err := coll.FindOne(ctx, bson.M{"$and": []bson.M{{"user_id": req.UserId}, {"deleted_at": "0"}, {"created_at": req.Date}}}).Decode(&summary)
-	if err != nil {
-		return nil, err
-	}
 
-	return &summary, nil
+// userIDHealthCursor UserIDHealth sahifasidagi oxirgi tavsiyaning saralash kalitlari
+type userIDHealthCursor struct {
+	Priority  int32     `json:"p"`
+	CreatedAt time.Time `json:"c"`
+	Id        string    `json:"i"`
 }
 
-func (h *Health) GetWeeklyHealthSummary(ctx context.Context, req *pb.GetWeeklyHealthSummaryRequest) (*pb.GetWeeklyHealthSummaryResponse, error) {
-	var summary pb.GetWeeklyHealthSummaryResponse
-	coll := h.Db.Collection("health")
-
-	startDateStr := req.StartDate
-
-	startDate, err := time.Parse("2006/01/02", startDateStr)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing start date: %v", err)
+// UserIDHealth foydalanuvchining o'chirilmagan barcha tavsiyalarini priority
+// (o'sish), so'ng sana (kamayish) bo'yicha saralab sahifalab qaytaradi
+func (h *Health) UserIDHealth(ctx context.Context, req *pb.UserIDHealthRequest) (*pb.UserIDHealthResponse, error) {
+	filter := bson.M{"user_id": req.UserId, "deleted_at": nil}
+	if req.RecommendationType != "" {
+		filter["recommendation_type"] = req.RecommendationType
 	}
 
-	weekAgo := startDate.AddDate(0, 0, -7)
-	weekAgoStr := weekAgo.Format("2006/01/02")
+	if req.Cursor != "" {
+		var c userIDHealthCursor
+		if err := decodeCursor(req.Cursor, &c); err != nil {
+			return nil, err
+		}
+		filter["$or"] = []bson.M{
+			{"priority": bson.M{"$gt": c.Priority}},
+			{"priority": c.Priority, "created_at": bson.M{"$lt": c.CreatedAt}},
+			{"priority": c.Priority, "created_at": c.CreatedAt, "id": bson.M{"$gt": c.Id}},
+		}
+	}
 
-	cursor, err := coll.Find(ctx, bson.M{
-		"$and": []bson.M{
-			{"user_id": req.UserId},
-			{"deleted_at": "0"},
-			{"created_at": bson.M{
-				"$gte": weekAgoStr,
-				"$lte": startDateStr,
-			}},
-		},
-	})
+	limit := pageSize(req.Limit)
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "priority", Value: 1}, {Key: "created_at", Value: -1}, {Key: "id", Value: 1}}).
+		SetLimit(limit + 1)
 
+	cursor, err := h.Db.Collection("health").Find(ctx, filter, findOptions)
 	if err != nil {
-		return nil, err
+		h.Logger.Error("Failed to list health recommendations", "user_id", req.UserId, "error", err)
+		return nil, fmt.Errorf("failed to fetch data from MongoDB: %v", err)
 	}
-
 	defer cursor.Close(ctx)
 
-	for cursor.Next(ctx) {
-		var doc pb.HealthRecommendation
-		if err := cursor.Decode(&doc); err != nil {
-			return nil, fmt.Errorf("error decoding document: %v", err)
+	var docs []healthRecommendationDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		h.Logger.Error("Failed to decode health recommendations", "user_id", req.UserId, "error", err)
+		return nil, err
+	}
+
+	resp := &pb.UserIDHealthResponse{}
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		last := docs[len(docs)-1]
+		resp.NextCursor, err = encodeCursor(userIDHealthCursor{Priority: last.Priority, CreatedAt: last.CreatedAt, Id: last.Id})
+		if err != nil {
+			return nil, err
 		}
-		summary.Health = append(summary.Health, &doc)
 	}
 
-	if err := cursor.Err(); err != nil {
-		return nil, fmt.Errorf("cursor error: %v", err)
+	for _, doc := range docs {
+		resp.Recommendations = append(resp.Recommendations, doc.toProto())
+	}
+
+	if err := h.audit(ctx, auditList, "health", "", req.UserId); err != nil {
+		return nil, err
 	}
 
-	return &summary, nil
+	return resp, nil
 }
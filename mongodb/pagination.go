@@ -0,0 +1,46 @@
+package mongoDb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// pageSize so'ralgan limitni [1, maxPageSize] oralig'iga keltiradi
+func pageSize(limit int64) int64 {
+	if limit <= 0 {
+		return defaultPageSize
+	}
+	if limit > maxPageSize {
+		return maxPageSize
+	}
+	return limit
+}
+
+// encodeCursor oxirgi qaytarilgan hujjatning saralash kalitlarini mijoz uchun
+// shaffof bo'lmagan satrga aylantiradi
+func encodeCursor(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeCursor(cursor string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "noto'g'ri cursor: %v", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return status.Errorf(codes.InvalidArgument, "noto'g'ri cursor: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package mongoDb
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPageSize(t *testing.T) {
+	tests := []struct {
+		limit int64
+		want  int64
+	}{
+		{-1, defaultPageSize},
+		{0, defaultPageSize},
+		{1, 1},
+		{maxPageSize, maxPageSize},
+		{maxPageSize + 1, maxPageSize},
+	}
+	for _, tt := range tests {
+		if got := pageSize(tt.limit); got != tt.want {
+			t.Errorf("pageSize(%d) = %d, want %d", tt.limit, got, tt.want)
+		}
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	at := time.Date(2026, 3, 1, 8, 30, 0, 123000000, time.UTC)
+
+	tests := []struct {
+		name string
+		in   any
+		out  any
+	}{
+		{"recorded", &recordedCursor{Recorded: at, Id: "w-1"}, &recordedCursor{}},
+		{"recommendations", &userIDHealthCursor{Priority: 2, CreatedAt: at, Id: "h-1"}, &userIDHealthCursor{}},
+		{"alerts", &listAlertsCursor{CreatedAt: at, Id: "a-1"}, &listAlertsCursor{}},
+		{"deleted", &listDeletedCursor{DeletedAt: at, Id: "m-1"}, &listDeletedCursor{}},
+		{"doctor list", &doctorListCursor{CreatedAt: at, Id: "c-1"}, &doctorListCursor{}},
+		{"audit", &auditLogCursor{Seq: 42}, &auditLogCursor{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := encodeCursor(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := decodeCursor(token, tt.out); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := mustEncode(t, tt.out), token; got != want {
+				t.Errorf("decoded cursor re-encodes to %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCursorKeepsTime(t *testing.T) {
+	at := time.Date(2026, 3, 1, 8, 30, 0, 123000000, time.UTC)
+	token, err := encodeCursor(recordedCursor{Recorded: at, Id: "w-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var c recordedCursor
+	if err := decodeCursor(token, &c); err != nil {
+		t.Fatal(err)
+	}
+	if !c.Recorded.Equal(at) || c.Id != "w-1" {
+		t.Errorf("decodeCursor() = %+v, want %v w-1", c, at)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "%%%"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("not json"))},
+		{"wrong shape", base64.RawURLEncoding.EncodeToString([]byte(`{"r":"yesterday"}`))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c recordedCursor
+			if err := decodeCursor(tt.cursor, &c); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("decodeCursor() error = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func mustEncode(t *testing.T, v any) string {
+	t.Helper()
+	token, err := encodeCursor(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
@@ -5,145 +5,440 @@ import (
 	"encoding/json"
 	"fmt"
 	pb "health/genproto/health_analytics"
-	"log"
+	"health/pkg/auth"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/streadway/amqp"
 	"go.mongodb.org/mongo-driver/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// AddWearableData yangi kiyiladigan qurilma ma'lumotlarini qo'shish uchun
-func (h *Health) ConsumeWearableDataQueue() {
-	// RabbitMQ queue’dan xabarlarni olish
-	messages, err := h.RabbitMQChannel.Consume(
-		"wearable_data_queue", // Queue nomi
-		"",                    // Consumer tag
-		true,                  // Auto-ack
-		false,                 // Exclusive
-		false,                 // No-local
-		false,                 // No-wait
-		nil,                   // Arguments
-	)
+// publishConfirmTimeout broker tasdig'ini kutishning yuqori chegarasi
+const publishConfirmTimeout = 5 * time.Second
+
+// maxWearableBatch AddWearableDataBatch dagi o'lchovlar soni chegarasi
+const maxWearableBatch = 500
+
+// wearableIDNamespace batch o'lchovlari id si shu namespace dagi UUIDv5
+var wearableIDNamespace = uuid.MustParse("a83a8b4a-ca08-4bc3-8968-a04f9c9ce532")
+
+// AddWearableData so'rovni tekshiradi, id beradi va xabarni wearable data navbatiga
+// yuboradi. Javob broker xabarni qabul qilganini tasdiqlagandan keyin qaytadi,
+// MongoDB ga yozish esa ConsumeWearableDataQueue da bajariladi.
+func (h *Health) AddWearableData(ctx context.Context, req *pb.AddWearableDataRequest) (*pb.AddWearableDataResponse, error) {
+	if err := validateWearableData(req); err != nil {
+		h.Logger.Warn("Invalid wearable data", "error", err)
+		return nil, err
+	}
+
+	measurement, err := normalizeMeasurement(req.DataType, req.DataValue, req.Measurement)
+	if err != nil {
+		h.Logger.Warn("Invalid wearable data value", "error", err)
+		return nil, err
+	}
+	req.Measurement = measurement
+
+	id := uuid.NewString()
+	vaqt := now()
+
+	msg, err := newWearableMessage(id, req)
+	if err != nil {
+		h.Logger.Error("Failed to marshal wearable data", "error", err)
+		return nil, err
+	}
+
+	err = h.publishMessage(ctx, "", h.Topology.WearableData.Name, msg)
 	if err != nil {
-		log.Fatal("failed to register a consumer: %w", err)
-		return
+		h.Logger.Error("Failed to publish wearable data", "error", err)
+		return nil, status.Errorf(codes.Unavailable, "kiyiladigan qurilma ma'lumotlari navbatga yozilmadi: %v", err)
 	}
 
-	for msg := range messages {
-		var message struct {
-			Id string `json:"id"`
-			*pb.AddWearableDataRequest
+	if err := h.audit(ctx, auditCreate, "wearable_data", id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.AddWearableDataResponse{WearableData: queuedWearableData(id, vaqt, req)}, nil
+}
+
+// AddWearableDataBatch o'lchovlarni tekshiradi va hammasini wearable data
+// navbatiga yuboradi. Biror o'lchov noto'g'ri bo'lsa hech biri yuborilmaydi.
+// Id lar o'lchovning o'zidan hosil qilinadi, shuning uchun xatolikdan keyin
+// batch ni qayta yuborish takror yozuv yaratmaydi.
+func (h *Health) AddWearableDataBatch(ctx context.Context, req *pb.AddWearableDataBatchRequest) (*pb.AddWearableDataBatchResponse, error) {
+	switch {
+	case len(req.Readings) == 0:
+		return nil, status.Error(codes.InvalidArgument, "readings bo'sh bo'lmasligi kerak")
+	case len(req.Readings) > maxWearableBatch:
+		return nil, status.Errorf(codes.InvalidArgument, "bitta so'rovda ko'pi bilan %d ta o'lchov yuborish mumkin", maxWearableBatch)
+	}
+
+	// Interceptor faqat so'rovning o'zidagi user_id ni tekshiradi
+	authorized := map[string]bool{}
+	for i, r := range req.Readings {
+		if err := validateWearableData(r); err != nil {
+			h.Logger.Warn("Invalid wearable data", "index", i, "error", err)
+			return nil, status.Errorf(codes.InvalidArgument, "readings[%d]: %s", i, status.Convert(err).Message())
 		}
-		err := json.Unmarshal(msg.Body, &message)
+		measurement, err := normalizeMeasurement(r.DataType, r.DataValue, r.Measurement)
 		if err != nil {
-			h.Logger.Error("Failed to unmarshal message", "error", err)
-			return
+			h.Logger.Warn("Invalid wearable data value", "index", i, "error", err)
+			return nil, status.Errorf(codes.InvalidArgument, "readings[%d]: %s", i, status.Convert(err).Message())
 		}
+		r.Measurement = measurement
 
-		wearableData := bson.M{
-			"id":                message.Id,
-			"userid":            message.UserId,
-			"devicetype":        message.DeviceType,
-			"datatype":          message.DataType,
-			"datavalue":         message.DataValue,
-			"recordedtimestamp": message.RecordedTimestamp,
-			"createdat":         time.Now().Format(time.RFC3339),
-			"updatedat":         time.Now().Format(time.RFC3339),
-			"deletedat":         "0",
+		if !authorized[r.UserId] {
+			if err := auth.Authorize(ctx, r.UserId); err != nil {
+				return nil, err
+			}
+			authorized[r.UserId] = true
 		}
-		// fmt.Println(wearableData)
+	}
+
+	vaqt := now()
+	msgs := make([]amqp.Publishing, len(req.Readings))
+	resp := &pb.AddWearableDataBatchResponse{WearableData: make([]*pb.WearableData, len(req.Readings))}
+	for i, r := range req.Readings {
+		naturalKey := wearableIngestKey(r.UserId, r.DeviceType, r.DataType, r.RecordedTimestamp.AsTime())
+		id := uuid.NewSHA1(wearableIDNamespace, []byte(naturalKey)).String()
 
-		_, err = h.Db.Collection("wearable_data").InsertOne(context.Background(), wearableData)
+		msg, err := newWearableMessage(id, r)
 		if err != nil {
-			h.Logger.Error("Failed to insert wearable data into MongoDB", "error", err)
-			return
+			h.Logger.Error("Failed to marshal wearable data", "error", err)
+			return nil, err
 		}
+		msgs[i] = msg
+		resp.WearableData[i] = queuedWearableData(id, vaqt, r)
+	}
+
+	if err := h.publishMessages(ctx, "", h.Topology.WearableData.Name, msgs); err != nil {
+		h.Logger.Error("Failed to publish wearable data batch", "count", len(msgs), "error", err)
+		return nil, status.Errorf(codes.Unavailable, "kiyiladigan qurilma ma'lumotlari navbatga yozilmadi: %v", err)
 	}
+
+	for _, d := range resp.WearableData {
+		if err := h.audit(ctx, auditCreate, "wearable_data", d.Id, d.UserId); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// wearableMessage wearable data navbatidagi xabar. recorded_timestamp navbatda
+// RFC3339 satr bo'lib qoladi, shunda tashqi producer lar va navbatda turgan
+// eski xabarlar ham o'qiladi.
+type wearableMessage struct {
+	Id                string          `json:"id"`
+	UserId            string          `json:"user_id,omitempty"`
+	DeviceType        string          `json:"device_type,omitempty"`
+	DataType          string          `json:"data_type,omitempty"`
+	DataValue         string          `json:"data_value,omitempty"`
+	RecordedTimestamp string          `json:"recorded_timestamp,omitempty"`
+	Measurement       *pb.Measurement `json:"measurement,omitempty"`
 }
 
-func (h *Health) ConsumeHealthRecommendationsQueue() {
-	// RabbitMQ queue’dan xabarlarni olish
-	messages, err := h.RabbitMQChannel.Consume(
-		"health_recommendations_queue", // Queue nomi
-		"",                             // Consumer tag
-		true,                           // Auto-ack
-		false,                          // Exclusive
-		false,                          // No-local
-		false,                          // No-wait
-		nil,                            // Arguments
-	)
+// queueTime vaqtni navbat xabaridagi ko'rinishga keltiradi
+func queueTime(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
+
+// newWearableMessage o'lchovni id bilan navbat xabariga aylantiradi
+func newWearableMessage(id string, req *pb.AddWearableDataRequest) (amqp.Publishing, error) {
+	body, err := json.Marshal(wearableMessage{
+		Id:                id,
+		UserId:            req.UserId,
+		DeviceType:        req.DeviceType,
+		DataType:          req.DataType,
+		DataValue:         req.DataValue,
+		RecordedTimestamp: queueTime(req.RecordedTimestamp),
+		Measurement:       req.Measurement,
+	})
 	if err != nil {
-		h.Logger.Error("Failed to register a consumer", "error", err)
-		return
+		return amqp.Publishing{}, err
+	}
+	return amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    id,
+		Timestamp:    time.Now(),
+		Body:         body,
+	}, nil
+}
+
+// queuedWearableData navbatga yuborilgan, hali yozilmagan o'lchov
+func queuedWearableData(id string, vaqt time.Time, req *pb.AddWearableDataRequest) *pb.WearableData {
+	return &pb.WearableData{
+		Id:                id,
+		UserId:            req.UserId,
+		DeviceType:        req.DeviceType,
+		DataType:          req.DataType,
+		DataValue:         req.DataValue,
+		RecordedTimestamp: req.RecordedTimestamp,
+		CreatedAt:         timestamppb.New(vaqt),
+		UpdatedAt:         timestamppb.New(vaqt),
+		Measurement:       req.Measurement,
+	}
+}
+
+func validateWearableData(req *pb.AddWearableDataRequest) error {
+	switch {
+	case req.GetUserId() == "":
+		return status.Error(codes.InvalidArgument, "user_id bo'sh bo'lmasligi kerak")
+	case req.GetDeviceType() == "":
+		return status.Error(codes.InvalidArgument, "device_type bo'sh bo'lmasligi kerak")
+	case req.GetDataType() == "":
+		return status.Error(codes.InvalidArgument, "data_type bo'sh bo'lmasligi kerak")
+	case req.GetDataValue() == "":
+		return status.Error(codes.InvalidArgument, "data_value bo'sh bo'lmasligi kerak")
 	}
+	_, err := requiredTime(req.GetRecordedTimestamp(), "recorded_timestamp")
+	return err
+}
 
-	for msg := range messages {
-		// Xabarni JSON formatidan chiqarish
-		var message struct {
-			UserId             string `json:"user_id"`
-			RecommendationType string `json:"recommendation_type"`
-			Description        string `json:"description"`
-			Priority           int    `json:"priority"`
+// publishWithConfirm JSON xabarni exchange ga yuboradi va broker ack qilguncha
+// kutadi. Bo'sh exchange default exchange, ya'ni routingKey nomli queue degani.
+func (h *Health) publishWithConfirm(ctx context.Context, exchange, routingKey string, body []byte) error {
+	return h.publishMessage(ctx, exchange, routingKey, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now(),
+		Body:         body,
+	})
+}
+
+// publishMessage xabarni yuboradi va broker tasdig'ini kutadi
+func (h *Health) publishMessage(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
+	return h.publishMessages(ctx, exchange, routingKey, []amqp.Publishing{msg})
+}
+
+// publishMessages xabarlarni ketma-ket yuboradi va broker hammasini tasdiqlashini
+// kutadi. Ulanish tiklanayotgan bo'lsa ctx tugaguncha kutadi. Kanal birinchi
+// chaqiruvda confirm rejimiga o'tkaziladi. Xatolik qaytsa xabarlarning bir qismi
+// navbatga tushgan bo'lishi mumkin.
+func (h *Health) publishMessages(ctx context.Context, exchange, routingKey string, msgs []amqp.Publishing) error {
+	h.publishMu.Lock()
+	defer h.publishMu.Unlock()
+
+	ch, err := h.MQ.Channel(ctx)
+	if err != nil {
+		return err
+	}
+	if ch != h.confirmCh {
+		if err := ch.Confirm(false); err != nil {
+			return fmt.Errorf("failed to put channel into confirm mode: %v", err)
 		}
+		h.confirmCh = ch
+		h.confirms = ch.NotifyPublish(make(chan amqp.Confirmation, maxWearableBatch))
+		h.publishSeq = 0
+	}
 
-		err := json.Unmarshal(msg.Body, &message)
+	first := h.publishSeq + 1
+	for _, msg := range msgs {
+		err = ch.Publish(
+			exchange,   // Exchange
+			routingKey, // Routing key
+			false,      // Mandatory
+			false,      // Immediate
+			msg,
+		)
 		if err != nil {
-			h.Logger.Error("Failed to unmarshal message", "error", err)
-			continue
+			return fmt.Errorf("failed to publish message: %v", err)
 		}
+		h.publishSeq++
+	}
 
-		// MongoDB kolleksiyasiga ulanadi
-		coll := h.Db.Collection("health")
-
-		// UUID va sana yaratish
-		id := uuid.NewString()
-		date := time.Now().Format("2006/01/02")
-
-		// MongoDB ga yangi tavsiya kiritish
-		_, err = coll.InsertOne(context.Background(), bson.M{
-			"id":                  id,
-			"user_id":             message.UserId,
-			"recommendation_type": message.RecommendationType,
-			"description":         message.Description,
-			"priority":            message.Priority,
-			"created_at":          date,
-			"updated_at":          date,
-			"deleted_at":          "0",
-		})
+	timer := time.NewTimer(publishConfirmTimeout)
+	defer timer.Stop()
 
-		fmt.Println(message.UserId)
-		fmt.Println(message.Description)
-		fmt.Println(message.Priority)
-		fmt.Println(message.RecommendationType)
+	for pending := len(msgs); pending > 0; {
+		select {
+		case confirm, ok := <-h.confirms:
+			if !ok {
+				h.confirmCh = nil
+				return fmt.Errorf("channel closed before publish was confirmed")
+			}
+			// Oldingi, vaqti o'tib ketgan publishlarning tasdiqlarini tashlab yuboramiz
+			if confirm.DeliveryTag < first {
+				continue
+			}
+			if !confirm.Ack {
+				return fmt.Errorf("broker nacked message %d", confirm.DeliveryTag)
+			}
+			pending--
+		case <-timer.C:
+			return fmt.Errorf("timed out waiting for publish confirm")
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// ConsumeWearableDataQueue wearable data navbatidagi xabarlarni MongoDB ga yozadi.
+// WearableData.BatchSize 1 dan katta bo'lsa yig'ilgan xabarlar bitta BulkWrite
+// bilan yoziladi va faqat yozuv muvaffaqiyatli bo'lgach ack qilinadi.
+func (h *Health) ConsumeWearableDataQueue(ctx context.Context) {
+	h.consumeQueue(ctx, h.Topology.WearableData, h.handleWearableBatch)
+}
+
+// wearableReading navbatdan o'qilgan va yozishga tayyor o'lchov
+type wearableReading struct {
+	doc         wearableDataDoc
+	measurement *pb.Measurement
+	msgIndex    int
+}
+
+func (h *Health) parseWearableMessage(msg amqp.Delivery) (wearableDataDoc, *pb.Measurement, error) {
+	var message wearableMessage
+	if err := json.Unmarshal(msg.Body, &message); err != nil {
+		return wearableDataDoc{}, nil, permanent(fmt.Errorf("failed to unmarshal message: %v", err))
+	}
+	// Egasiz o'lchov hech kimga ko'rinmaydi va bo'sh user_id kanaliga tushadi
+	if message.UserId == "" || message.DataType == "" {
+		return wearableDataDoc{}, nil, permanent(fmt.Errorf("message has no user_id or data_type"))
+	}
+	recordedTimestamp, err := time.Parse(time.RFC3339, message.RecordedTimestamp)
+	if err != nil {
+		return wearableDataDoc{}, nil, permanent(fmt.Errorf("invalid recorded_timestamp: %v", err))
+	}
+	if message.Id == "" {
+		message.Id = msg.MessageId
+	}
+	if message.Id == "" {
+		message.Id = uuid.NewString()
+	}
+
+	// Noto'g'ri qiymat qayta urinishda ham to'g'rilanmaydi, xabar dead-letter ga
+	// tushadi va bazaga o'lchovsiz yozilmaydi
+	measurement, err := normalizeMeasurement(message.DataType, message.DataValue, message.Measurement)
+	if err != nil {
+		return wearableDataDoc{}, nil, permanent(fmt.Errorf("invalid data_value: %v", err))
+	}
+
+	vaqt := now()
+	return wearableDataDoc{
+		Id:                message.Id,
+		UserId:            message.UserId,
+		DeviceType:        message.DeviceType,
+		DataType:          message.DataType,
+		DataValue:         message.DataValue,
+		Measurement:       newMeasurementDoc(measurement),
+		RecordedTimestamp: recordedTimestamp.UTC().Truncate(time.Millisecond),
+		CreatedAt:         vaqt,
+		UpdatedAt:         vaqt,
+		IngestKey:         wearableIngestKey(message.UserId, message.DeviceType, message.DataType, recordedTimestamp),
+	}, measurement, nil
+}
 
+// handleWearableBatch xabarlarni bitta BulkWrite bilan yozadi. Takror kelgan
+// xabarlar uchun alert va realtime event birinchisida chiqarilgan, ular o'tkazib
+// yuboriladi.
+func (h *Health) handleWearableBatch(ctx context.Context, msgs []amqp.Delivery) []error {
+	errs := make([]error, len(msgs))
+	readings := make([]wearableReading, 0, len(msgs))
+	for i, msg := range msgs {
+		doc, measurement, err := h.parseWearableMessage(msg)
 		if err != nil {
-			h.Logger.Error("Failed to insert health recommendation into MongoDB", "error", err)
+			errs[i] = err
 			continue
 		}
+		readings = append(readings, wearableReading{doc: doc, measurement: measurement, msgIndex: i})
+	}
 
-		// Redis uchun ma'lumotlarni tayyorlash va saqlash
-		redisKey := message.UserId
-		redisValue, err := json.Marshal(bson.M{
-			"id":                  id,
-			"user_id":             message.UserId,
-			"recommendation_type": message.RecommendationType,
-			"description":         message.Description,
-			"priority":            message.Priority,
-			"created_at":          date,
-			"updated_at":          date,
-		})
-		if err != nil {
-			h.Logger.Error("Failed to marshal recommendation for Redis", "error", err)
+	docs := make([]wearableDataDoc, len(readings))
+	for j, r := range readings {
+		docs[j] = r.doc
+	}
+	inserted, writeErrs := h.ingestWearableBatch(ctx, docs)
+
+	for j, r := range readings {
+		if writeErrs[j] != nil {
+			errs[r.msgIndex] = fmt.Errorf("failed to insert wearable data into MongoDB: %w", writeErrs[j])
 			continue
 		}
-
-		// Redisga yozish
-		err = h.Redis.Set(context.Background(), redisKey, redisValue, 0).Err()
-		if err != nil {
-			h.Logger.Error("Failed to write recommendation to Redis", "error", err)
+		if !inserted[j] {
+			h.Logger.Info("Skipped duplicate wearable data", "id", r.doc.Id, "ingest_key", r.doc.IngestKey)
 			continue
 		}
+
+		h.checkAlerts(ctx, r.doc.Id, r.doc.UserId, r.doc.DataType, r.measurement)
+
+		h.publishRealtime(ctx, r.doc.UserId, &pb.RealtimeHealthEvent{
+			Event: &pb.RealtimeHealthEvent_WearableData{WearableData: r.doc.toProto()},
+		})
+	}
+	return errs
+}
+
+// ConsumeHealthRecommendationsQueue tashqaridan kelgan tavsiyalarni MongoDB va Redis ga yozadi
+func (h *Health) ConsumeHealthRecommendationsQueue(ctx context.Context) {
+	h.consumeQueue(ctx, h.Topology.HealthRecommendations, oneByOne(h.handleHealthRecommendation))
+}
+
+func (h *Health) handleHealthRecommendation(ctx context.Context, msg amqp.Delivery) error {
+	// Xabarni JSON formatidan chiqarish
+	var message struct {
+		UserId             string `json:"user_id"`
+		RecommendationType string `json:"recommendation_type"`
+		Description        string `json:"description"`
+		Priority           int    `json:"priority"`
+	}
+	if err := json.Unmarshal(msg.Body, &message); err != nil {
+		return permanent(fmt.Errorf("failed to unmarshal message: %v", err))
+	}
+
+	// MongoDB kolleksiyasiga ulanadi
+	coll := h.Db.Collection("health")
+
+	// UUID va vaqt yaratish
+	id := uuid.NewString()
+	vaqt := now()
+
+	// MongoDB ga yangi tavsiya kiritish
+	_, err := coll.InsertOne(ctx, bson.M{
+		"id":                  id,
+		"user_id":             message.UserId,
+		"recommendation_type": message.RecommendationType,
+		"description":         message.Description,
+		"priority":            message.Priority,
+		"created_at":          vaqt,
+		"updated_at":          vaqt,
+		"deleted_at":          nil,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert health recommendation into MongoDB: %v", err)
+	}
+
+	recommendation := &pb.HealthRecommendation{
+		Id:                 id,
+		UserId:             message.UserId,
+		RecommendationType: message.RecommendationType,
+		Description:        message.Description,
+		Priority:           int32(message.Priority),
+		CreatedAt:          timestamppb.New(vaqt),
+		UpdatedAt:          timestamppb.New(vaqt),
+	}
+	h.publishRealtime(ctx, message.UserId, &pb.RealtimeHealthEvent{
+		Event: &pb.RealtimeHealthEvent_Recommendation{Recommendation: recommendation},
+	})
+
+	// Redis uchun ma'lumotlarni tayyorlash va saqlash. Tavsiya bazaga yozilgan,
+	// shuning uchun Redis xatoligida xabar qayta urinishga yuborilmaydi.
+	redisKey := message.UserId
+	redisValue, err := protojson.Marshal(recommendation)
+	if err != nil {
+		h.Logger.Error("Failed to marshal recommendation for Redis", "error", err)
+		return nil
+	}
+
+	// Redisga yozish
+	if err := h.Redis.Set(ctx, redisKey, redisValue, 0).Err(); err != nil {
+		h.Logger.Error("Failed to write recommendation to Redis", "error", err)
 	}
+	return nil
 }
 
 // coll := h.Db.Collection("health")
@@ -0,0 +1,42 @@
+package mongoDb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/streadway/amqp"
+)
+
+func TestParseWearableMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{"valid", `{"id":"w-1","user_id":"user-1","device_type":"watch","data_type":"heart_rate","data_value":"72","recorded_timestamp":"2026-03-01T08:30:00+05:00"}`, false},
+		{"no user_id", `{"id":"w-1","device_type":"watch","data_type":"heart_rate","data_value":"72","recorded_timestamp":"2026-03-01T08:30:00Z"}`, true},
+		{"no data_type", `{"id":"w-1","user_id":"user-1","device_type":"watch","data_value":"72","recorded_timestamp":"2026-03-01T08:30:00Z"}`, true},
+		{"bad json", `{"user_id":`, true},
+		{"bad timestamp", `{"user_id":"user-1","data_type":"heart_rate","data_value":"72","recorded_timestamp":"yesterday"}`, true},
+		{"bad value", `{"user_id":"user-1","data_type":"heart_rate","data_value":"fast","recorded_timestamp":"2026-03-01T08:30:00Z"}`, true},
+	}
+	h := &Health{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, _, err := h.parseWearableMessage(amqp.Delivery{Body: []byte(tt.body)})
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if doc.UserId != "user-1" || doc.IngestKey != "natural:user-1|watch|heart_rate|2026-03-01T03:30:00.000Z" {
+					t.Errorf("parseWearableMessage() = %+v", doc)
+				}
+				return
+			}
+			var perm permanentError
+			if !errors.As(err, &perm) {
+				t.Errorf("parseWearableMessage() error = %v, want permanent", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package mongoDb
+
+import (
+	"context"
+
+	pb "health/genproto/health_analytics"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// realtimeChannel foydalanuvchi hodisalari uchun Redis pub/sub kanali. Kanal
+// Redis orqali bo'lgani uchun hodisani qaysi replika o'qigani muhim emas, uni
+// har bir replikadagi obunachilar oladi.
+func realtimeChannel(userID string) string {
+	return "health:realtime:" + userID
+}
+
+// publishRealtime hodisani foydalanuvchi kanaliga yuboradi. Xatolik faqat
+// loglanadi: realtime oqim ma'lumotni saqlashga to'sqinlik qilmasligi kerak.
+func (h *Health) publishRealtime(ctx context.Context, userID string, event *pb.RealtimeHealthEvent) {
+	payload, err := protojson.Marshal(event)
+	if err != nil {
+		h.Logger.Error("Failed to marshal realtime event", "error", err)
+		return
+	}
+
+	if err := h.Redis.Publish(ctx, realtimeChannel(userID), payload).Err(); err != nil {
+		h.Logger.Error("Failed to publish realtime event to Redis", "user_id", userID, "error", err)
+	}
+}
+
+// StreamRealtimeHealthMonitoring foydalanuvchining navbatdan o'qilgan har bir
+// yangi o'lchovi va tavsiyasini mijoz uzilguncha oqim sifatida yuboradi
+func (h *Health) StreamRealtimeHealthMonitoring(req *pb.StreamRealtimeHealthMonitoringRequest, stream pb.HealthAnalyticsService_StreamRealtimeHealthMonitoringServer) error {
+	// Interceptor bo'sh user_id ni tekshirmaydi, bo'sh kanalga esa hech kim
+	// obuna bo'lmasligi kerak
+	if req.UserId == "" {
+		return status.Error(codes.InvalidArgument, "user_id bo'sh bo'lmasligi kerak")
+	}
+	ctx := stream.Context()
+
+	sub := h.Redis.Subscribe(ctx, realtimeChannel(req.UserId))
+	defer sub.Close()
+
+	// Obuna tasdiqlanmaguncha kelgan hodisalar yo'qolmasligi uchun kutamiz
+	if _, err := sub.Receive(ctx); err != nil {
+		h.Logger.Error("Failed to subscribe to realtime channel", "user_id", req.UserId, "error", err)
+		return err
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+
+			var event pb.RealtimeHealthEvent
+			if err := protojson.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				h.Logger.Warn("Failed to unmarshal realtime event", "error", err)
+				continue
+			}
+
+			if err := stream.Send(&event); err != nil {
+				h.Logger.Warn("Failed to send realtime event", "user_id", req.UserId, "error", err)
+				return err
+			}
+		}
+	}
+}
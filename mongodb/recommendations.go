@@ -0,0 +1,447 @@
+package mongoDb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"text/template"
+	"time"
+
+	pb "health/genproto/health_analytics"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RecommendationRule foydalanuvchi ma'lumotlariga qo'llanadigan bitta qoida.
+// Source dagi DataType yozuvlari Window kun ichida Aggregate bilan hisoblanadi va
+// natija Operator bo'yicha Threshold bilan solishtiriladi. daily_avg avval har
+// kun (foydalanuvchi zonasida) qiymatlarini qo'shadi, so'ng kunlik yig'indilarning
+// o'rtachasini oladi; bunda faqat tugagan kunlar hisoblanadi.
+type RecommendationRule struct {
+	Name               string  `json:"name"`
+	Source             string  `json:"source"`    // wearable_data, lifestyle_data yoki medical_records
+	DataType           string  `json:"data_type"` // medical_records uchun record_type
+	Aggregate          string  `json:"aggregate"` // avg, daily_avg, min, max, latest yoki days_since
+	Operator           string  `json:"operator"`  // gt, gte, lt yoki lte
+	Threshold          float64 `json:"threshold"`
+	WindowDays         int     `json:"window_days"`
+	RecommendationType string  `json:"recommendation_type"`
+	Description        string  `json:"description"` // text/template: {{.Value}}, {{.Threshold}}, {{.Count}}
+	Priority           int32   `json:"priority"`
+}
+
+// healthRecommendationDoc health kolleksiyasidagi hujjat ko'rinishi
+type healthRecommendationDoc struct {
+	Id                 string    `bson:"id"`
+	UserId             string    `bson:"user_id"`
+	RecommendationType string    `bson:"recommendation_type"`
+	Description        string    `bson:"description"`
+	Priority           int32     `bson:"priority"`
+	CreatedAt          time.Time `bson:"created_at"`
+	UpdatedAt          time.Time `bson:"updated_at"`
+}
+
+func (d healthRecommendationDoc) toProto() *pb.HealthRecommendation {
+	return &pb.HealthRecommendation{
+		Id:                 d.Id,
+		UserId:             d.UserId,
+		RecommendationType: d.RecommendationType,
+		Description:        d.Description,
+		Priority:           d.Priority,
+		CreatedAt:          protoTime(d.CreatedAt),
+		UpdatedAt:          protoTime(d.UpdatedAt),
+	}
+}
+
+// DefaultRecommendationRules RECOMMENDATION_RULES_FILE berilmaganda ishlatiladi
+var DefaultRecommendationRules = []RecommendationRule{
+	{
+		Name:               "high_resting_heart_rate",
+		Source:             "wearable_data",
+		DataType:           "heart_rate",
+		Aggregate:          "avg",
+		Operator:           "gt",
+		Threshold:          100,
+		WindowDays:         7,
+		RecommendationType: "cardio",
+		Description:        `Oxirgi 7 kunda o'rtacha yurak urishi {{printf "%.0f" .Value}} zarba/daqiqa ({{printf "%.0f" .Threshold}} dan yuqori). Kardiolog bilan maslahatlashing.`,
+		Priority:           1,
+	},
+	{
+		Name:               "short_sleep",
+		Source:             "lifestyle_data",
+		DataType:           "sleep",
+		Aggregate:          "avg",
+		Operator:           "lt",
+		Threshold:          6,
+		WindowDays:         7,
+		RecommendationType: "sleep",
+		Description:        `Oxirgi 7 kunda o'rtacha uyqu {{printf "%.1f" .Value}} soat. Kuniga kamida {{printf "%.0f" .Threshold}} soat uxlashga harakat qiling.`,
+		Priority:           2,
+	},
+	{
+		Name:               "low_activity",
+		Source:             "wearable_data",
+		DataType:           "steps",
+		Aggregate:          "daily_avg",
+		Operator:           "lt",
+		Threshold:          5000,
+		WindowDays:         7,
+		RecommendationType: "activity",
+		Description:        `Kunlik o'rtacha qadamlar soni {{printf "%.0f" .Value}}. Kuniga kamida {{printf "%.0f" .Threshold}} qadam yurishni tavsiya qilamiz.`,
+		Priority:           3,
+	},
+	{
+		Name:               "overdue_checkup",
+		Source:             "medical_records",
+		DataType:           "checkup",
+		Aggregate:          "days_since",
+		Operator:           "gt",
+		Threshold:          365,
+		RecommendationType: "checkup",
+		Description:        `{{if .Count}}Oxirgi tibbiy ko'rikdan beri {{printf "%.0f" .Value}} kun o'tdi.{{else}}Tibbiy ko'rik haqida yozuv topilmadi.{{end}} Yillik ko'rikdan o'ting.`,
+		Priority:           2,
+	},
+}
+
+// LoadRecommendationRules qoidalarni JSON fayldan o'qiydi. path bo'sh bo'lsa
+// DefaultRecommendationRules qaytariladi.
+func LoadRecommendationRules(path string) ([]RecommendationRule, error) {
+	if path == "" {
+		return DefaultRecommendationRules, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recommendation rules: %v", err)
+	}
+
+	var rules []RecommendationRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse recommendation rules: %v", err)
+	}
+
+	for _, rule := range rules {
+		if err := rule.validate(); err != nil {
+			return nil, fmt.Errorf("invalid recommendation rule %q: %v", rule.Name, err)
+		}
+	}
+
+	return rules, nil
+}
+
+// ruleAggregates har bir source uchun evaluateRule hisoblay oladigan aggregate lar
+var ruleAggregates = map[string][]string{
+	"wearable_data":   {"avg", "daily_avg", "min", "max", "latest"},
+	"lifestyle_data":  {"avg", "daily_avg", "min", "max", "latest"},
+	"medical_records": {"days_since"},
+}
+
+func (r RecommendationRule) validate() error {
+	aggregates, ok := ruleAggregates[r.Source]
+	if !ok {
+		return fmt.Errorf("unknown source %q", r.Source)
+	}
+	if !slices.Contains(aggregates, r.Aggregate) {
+		return fmt.Errorf("aggregate %q is not supported for source %s", r.Aggregate, r.Source)
+	}
+	switch r.Operator {
+	case "gt", "gte", "lt", "lte":
+	default:
+		return fmt.Errorf("unknown operator %q", r.Operator)
+	}
+	if r.Name == "" || r.DataType == "" || r.RecommendationType == "" {
+		return fmt.Errorf("name, data_type and recommendation_type are required")
+	}
+	_, err := template.New(r.Name).Parse(r.Description)
+	return err
+}
+
+// GenerateHealthRecommendations foydalanuvchi ma'lumotlarini qoidalar bo'yicha
+// tekshiradi va ishga tushgan har bir qoida uchun health kolleksiyasiga tavsiya yozadi.
+// Bugun (foydalanuvchi vaqt zonasidagi kunda) shu qoida bo'yicha tavsiya
+// yaratilgan bo'lsa u qayta yaratilmaydi.
+func (h *Health) GenerateHealthRecommendations(ctx context.Context, req *pb.GenerateHealthRecommendationsRequest) (*pb.GenerateHealthRecommendationsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id bo'sh bo'lmasligi kerak")
+	}
+
+	now := now()
+	loc := h.location(ctx, req.UserId)
+	today := startOfDay(now, loc)
+	coll := h.Db.Collection("health")
+
+	var created []*pb.HealthRecommendation
+	for _, rule := range h.Rules {
+		if req.RecommendationType != "" && rule.RecommendationType != req.RecommendationType {
+			continue
+		}
+
+		value, count, err := h.evaluateRule(ctx, req.UserId, rule, now, loc)
+		if err != nil {
+			h.Logger.Error("Failed to evaluate recommendation rule", "rule", rule.Name, "error", err)
+			return nil, err
+		}
+		if count == 0 && rule.Aggregate != "days_since" {
+			continue
+		}
+		if !compare(value, rule.Operator, rule.Threshold) {
+			continue
+		}
+
+		exists, err := coll.CountDocuments(ctx, bson.M{
+			"user_id":    req.UserId,
+			"rule":       rule.Name,
+			"created_at": bson.M{"$gte": today, "$lt": today.AddDate(0, 0, 1)},
+			"deleted_at": nil,
+		})
+		if err != nil {
+			h.Logger.Error("Failed to check existing recommendation", "rule", rule.Name, "error", err)
+			return nil, err
+		}
+		if exists > 0 {
+			continue
+		}
+
+		description, err := renderDescription(rule, value, count)
+		if err != nil {
+			h.Logger.Error("Failed to render recommendation description", "rule", rule.Name, "error", err)
+			return nil, err
+		}
+
+		recommendation := &pb.HealthRecommendation{
+			Id:                 uuid.NewString(),
+			UserId:             req.UserId,
+			RecommendationType: rule.RecommendationType,
+			Description:        description,
+			Priority:           rule.Priority,
+			CreatedAt:          timestamppb.New(now),
+			UpdatedAt:          timestamppb.New(now),
+		}
+
+		_, err = coll.InsertOne(ctx, bson.M{
+			"id":                  recommendation.Id,
+			"user_id":             recommendation.UserId,
+			"recommendation_type": recommendation.RecommendationType,
+			"description":         recommendation.Description,
+			"priority":            recommendation.Priority,
+			"rule":                rule.Name,
+			"created_at":          now,
+			"updated_at":          now,
+			"deleted_at":          nil,
+		})
+		if err != nil {
+			h.Logger.Error("Failed to insert health recommendation into MongoDB", "error", err)
+			return nil, err
+		}
+
+		created = append(created, recommendation)
+		h.publishRealtime(ctx, req.UserId, &pb.RealtimeHealthEvent{
+			Event: &pb.RealtimeHealthEvent_Recommendation{Recommendation: recommendation},
+		})
+	}
+
+	// Realtime monitoring oxirgi tavsiyani Redisdan o'qiydi
+	if len(created) > 0 {
+		latest := created[0]
+		for _, r := range created[1:] {
+			if r.Priority < latest.Priority {
+				latest = r
+			}
+		}
+		redisValue, err := protojson.Marshal(latest)
+		if err != nil {
+			h.Logger.Error("Failed to marshal recommendation for Redis", "error", err)
+		} else if err := h.Redis.Set(ctx, req.UserId, redisValue, 0).Err(); err != nil {
+			h.Logger.Error("Failed to write recommendation to Redis", "error", err)
+		}
+	}
+
+	return &pb.GenerateHealthRecommendationsResponse{Recommendations: created}, nil
+}
+
+// evaluateRule qoida uchun hisoblangan qiymat va unga kirgan yozuvlar sonini
+// qaytaradi. Kunlar loc zonasida hisoblanadi.
+func (h *Health) evaluateRule(ctx context.Context, userID string, rule RecommendationRule, now time.Time, loc *time.Location) (float64, int, error) {
+	if rule.Source == "medical_records" {
+		return h.daysSinceLastRecord(ctx, userID, rule.DataType, now)
+	}
+
+	timeField := "recorded_timestamp"
+	if rule.Source == "lifestyle_data" {
+		timeField = "recorded_date"
+	}
+
+	filter := bson.M{"user_id": userID, "data_type": rule.DataType, "deleted_at": nil}
+	if rule.Aggregate == "daily_avg" {
+		// Bugun hali tugamagan, uning yig'indisi o'rtachani pasaytirib yuboradi
+		today := startOfDay(now, loc)
+		window := bson.M{"$lt": today}
+		if rule.WindowDays > 0 {
+			window["$gte"] = today.AddDate(0, 0, -rule.WindowDays)
+		}
+		filter[timeField] = window
+	} else if rule.WindowDays > 0 {
+		filter[timeField] = bson.M{"$gte": now.AddDate(0, 0, -rule.WindowDays)}
+	}
+	cursor, err := h.Db.Collection(rule.Source).Find(ctx, filter,
+		options.Find().SetProjection(bson.M{"data_value": 1, "measurement": 1, timeField: 1}),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var (
+		values     []float64
+		daily      = map[string]float64{}
+		latest     float64
+		latestTime time.Time
+	)
+	for cursor.Next(ctx) {
+		var doc struct {
+			DataValue   string          `bson:"data_value"`
+			Measurement *measurementDoc `bson:"measurement"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return 0, 0, err
+		}
+		recordedAt, ok := cursor.Current.Lookup(timeField).TimeOK()
+		if !ok {
+			continue
+		}
+
+		var value float64
+		if doc.Measurement != nil {
+			value = doc.Measurement.Value
+		} else if value, err = strconv.ParseFloat(doc.DataValue, 64); err != nil {
+			continue
+		}
+
+		values = append(values, value)
+		daily[recordedAt.In(loc).Format(dayKey)] += value
+		if recordedAt.After(latestTime) {
+			latest, latestTime = value, recordedAt
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return 0, 0, err
+	}
+	if len(values) == 0 {
+		return 0, 0, nil
+	}
+
+	switch rule.Aggregate {
+	case "min":
+		result := math.Inf(1)
+		for _, v := range values {
+			result = math.Min(result, v)
+		}
+		return result, len(values), nil
+	case "max":
+		result := math.Inf(-1)
+		for _, v := range values {
+			result = math.Max(result, v)
+		}
+		return result, len(values), nil
+	case "latest":
+		return latest, len(values), nil
+	case "daily_avg":
+		var sum float64
+		for _, v := range daily {
+			sum += v
+		}
+		return sum / float64(len(daily)), len(values), nil
+	default:
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values)), len(values), nil
+	}
+}
+
+// daysSinceLastRecord berilgan turdagi oxirgi tibbiy yozuvdan beri o'tgan kunlar.
+// Yozuv umuman bo'lmasa math.MaxInt32 qaytariladi, ya'ni ko'rik muddati o'tgan.
+func (h *Health) daysSinceLastRecord(ctx context.Context, userID, recordType string, now time.Time) (float64, int, error) {
+	cursor, err := h.Db.Collection("medical_records").Find(ctx,
+		bson.M{"user_id": userID, "record_type": recordType, "deleted_at": nil},
+		options.Find().SetProjection(bson.M{"record_date": 1}),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var (
+		last  time.Time
+		count int
+	)
+	for cursor.Next(ctx) {
+		var doc struct {
+			RecordDate time.Time `bson:"record_date"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return 0, 0, err
+		}
+		if doc.RecordDate.IsZero() {
+			continue
+		}
+		count++
+		if doc.RecordDate.After(last) {
+			last = doc.RecordDate
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return 0, 0, err
+	}
+	if count == 0 {
+		return math.MaxInt32, 0, nil
+	}
+
+	return math.Floor(now.Sub(last).Hours() / 24), count, nil
+}
+
+func compare(value float64, operator string, threshold float64) bool {
+	switch operator {
+	case "gt":
+		return value > threshold
+	case "gte":
+		return value >= threshold
+	case "lt":
+		return value < threshold
+	case "lte":
+		return value <= threshold
+	}
+	return false
+}
+
+func renderDescription(rule RecommendationRule, value float64, count int) (string, error) {
+	tmpl, err := template.New(rule.Name).Parse(rule.Description)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, struct {
+		Value     float64
+		Threshold float64
+		Count     int
+	}{value, rule.Threshold, count})
+	if err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,133 @@
+package mongoDb
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// minSearchWordLen bundan qisqa so'zlar qidiruvga kirmaydi
+const minSearchWordLen = 2
+
+// searchWords matnni kichik harfli, takrorlanmaydigan so'zlarga ajratadi
+func searchWords(text string) []string {
+	seen := map[string]bool{}
+	var words []string
+	for _, w := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(w)) < minSearchWordLen || seen[w] {
+			continue
+		}
+		seen[w] = true
+		words = append(words, w)
+	}
+	return words
+}
+
+// hashSearchWords so'zlarni data key dan olingan HMAC kaliti bilan blind index
+// tokenlariga aylantiradi. Token oldidagi versiya qaysi kalit bilan
+// hisoblanganini bildiradi.
+func hashSearchWords(key []byte, version int, words []string) []string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("medical_records/search"))
+	searchKey := mac.Sum(nil)
+
+	tokens := make([]string, 0, len(words))
+	for _, w := range words {
+		mac := hmac.New(sha256.New, searchKey)
+		mac.Write([]byte(w))
+		tokens = append(tokens, strconv.Itoa(version)+":"+base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:16]))
+	}
+	return tokens
+}
+
+// searchTokens description bo'yicha qidirish uchun saqlanadigan tokenlar.
+// Master key sozlanmagan bo'lsa description ochiq saqlanadi va token so'zning
+// o'zi, aks holda foydalanuvchining joriy data key i bilan hisoblangan HMAC.
+func (h *Health) searchTokens(ctx context.Context, userID, description string) ([]string, error) {
+	words := searchWords(description)
+	if h.Keys == nil {
+		return words, nil
+	}
+	version, key, err := h.currentDataKey(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return hashSearchWords(key, version, words), nil
+}
+
+// searchFilter query dagi hamma so'zlari bo'lgan yozuvlar filtri. Tokenlar
+// foydalanuvchining har bir data key versiyasi uchun hisoblanadi, shifrlash
+// yoqilishidan oldin yozilgan ochiq tokenlar ham qidiriladi.
+func (h *Health) searchFilter(ctx context.Context, userID string, words []string) (bson.M, error) {
+	alternatives := bson.A{bson.M{"search_tokens": bson.M{"$all": words}}}
+	if h.Keys != nil {
+		versions, err := h.Db.Collection("data_keys").Distinct(ctx, "version", bson.M{"user_id": userID})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			var version int
+			switch v := v.(type) {
+			case int32:
+				version = int(v)
+			case int64:
+				version = int(v)
+			default:
+				continue
+			}
+			key, err := h.dataKey(ctx, userID, version)
+			if err != nil {
+				return nil, err
+			}
+			alternatives = append(alternatives, bson.M{"search_tokens": bson.M{"$all": hashSearchWords(key, version, words)}})
+		}
+	}
+	return bson.M{"$or": alternatives}, nil
+}
+
+// RebuildSearchTokens barcha tibbiy yozuvlarning qidiruv tokenlarini qayta
+// hisoblaydi: qidiruv qo'shilishidan oldingi yozuvlar va master key
+// yoqilgandan keyin ochiq tokenli qolganlar uchun
+func (h *Health) RebuildSearchTokens(ctx context.Context) (int, error) {
+	coll := h.Db.Collection("medical_records")
+	cursor, err := coll.Find(ctx, bson.M{})
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	rebuilt := 0
+	for cursor.Next(ctx) {
+		var record medicalRecordDoc
+		if err := cursor.Decode(&record); err != nil {
+			return rebuilt, err
+		}
+		original := record.Description
+		if err := h.decryptRecord(ctx, &record); err != nil {
+			return rebuilt, err
+		}
+		tokens, err := h.searchTokens(ctx, record.UserId, record.Description)
+		if err != nil {
+			return rebuilt, err
+		}
+
+		// Shu orada yangilangan yozuvning tokenlari allaqachon yangi
+		_, err = coll.UpdateOne(ctx,
+			bson.M{"id": record.Id, "description": original},
+			bson.M{"$set": bson.M{"search_tokens": tokens}},
+		)
+		if err != nil {
+			return rebuilt, err
+		}
+		rebuilt++
+	}
+	return rebuilt, cursor.Err()
+}
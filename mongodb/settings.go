@@ -0,0 +1,85 @@
+package mongoDb
+
+import (
+	"context"
+	"time"
+
+	pb "health/genproto/health_analytics"
+	"health/pkg/auth"
+	"health/pkg/timezone"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userSettingsDoc user_settings kolleksiyasidagi hujjat, har foydalanuvchiga bitta
+type userSettingsDoc struct {
+	UserId    string    `bson:"user_id"`
+	Timezone  string    `bson:"timezone"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// SetUserTimezone foydalanuvchining vaqt zonasini saqlaydi. Bo'sh timezone
+// saqlangan zonani o'chiradi. Zona bemorning kun chegaralarini o'zgartirgani
+// uchun uni shifokor rozilik bilan ham o'zgartira olmaydi.
+func (h *Health) SetUserTimezone(ctx context.Context, req *pb.SetUserTimezoneRequest) (*pb.SetUserTimezoneResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id kiritilmagan")
+	}
+	if err := auth.AuthorizeSelf(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if req.Timezone != "" {
+		if _, err := timezone.Load(req.Timezone); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "noto'g'ri vaqt zonasi: %q", req.Timezone)
+		}
+	}
+
+	_, err := h.Db.Collection("user_settings").UpdateOne(ctx,
+		bson.M{"user_id": req.UserId},
+		bson.M{"$set": bson.M{"timezone": req.Timezone, "updated_at": now()}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		h.Logger.Error("Failed to save user timezone", "user_id", req.UserId, "error", err)
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditUpdate, "user_settings", req.UserId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetUserTimezoneResponse{Success: true}, nil
+}
+
+// GetUserTimezone foydalanuvchining saqlangan vaqt zonasini qaytaradi
+func (h *Health) GetUserTimezone(ctx context.Context, req *pb.GetUserTimezoneRequest) (*pb.GetUserTimezoneResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id kiritilmagan")
+	}
+
+	doc, err := h.userSettings(ctx, req.UserId)
+	if err != nil {
+		h.Logger.Error("Failed to get user timezone", "user_id", req.UserId, "error", err)
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditGet, "user_settings", req.UserId, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserTimezoneResponse{Timezone: doc.Timezone, UpdatedAt: protoTime(doc.UpdatedAt)}, nil
+}
+
+// userSettings foydalanuvchi sozlamalari, hujjat bo'lmasa bo'sh qiymat
+func (h *Health) userSettings(ctx context.Context, userID string) (userSettingsDoc, error) {
+	var doc userSettingsDoc
+	err := h.Db.Collection("user_settings").FindOne(ctx, bson.M{"user_id": userID}).Decode(&doc)
+	if err == mongo.ErrNoDocuments {
+		return userSettingsDoc{}, nil
+	}
+	return doc, err
+}
@@ -0,0 +1,357 @@
+package mongoDb
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	authpb "health/genproto/auth"
+	pb "health/genproto/health_analytics"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// summarySources xulosaga kiradigan kolleksiyalar va ulardagi o'lchov vaqti maydoni
+var summarySources = []struct {
+	Collection string
+	TimeField  string
+}{
+	{"wearable_data", "recorded_timestamp"},
+	{"lifestyle_data", "recorded_date"},
+}
+
+// parseSummaryDate so'rovdagi sanani loc dagi kun boshi sifatida o'qiydi:
+// "2006/01/02" yoki "2006-01-02"
+func parseSummaryDate(value string, loc *time.Location) (time.Time, error) {
+	for _, layout := range []string{"2006/01/02", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, status.Errorf(codes.InvalidArgument, "sana 2006/01/02 formatida bo'lishi kerak: %q", value)
+}
+
+// dayKey kunlarni xaritada bir xil ko'rinishda saqlash uchun, aggregateMetrics
+// dagi $dateToString formati bilan bir xil
+const dayKey = "2006-01-02"
+
+// aggregateMetrics kolleksiyadagi foydalanuvchining [from, to) oralig'idagi
+// yozuvlarini loc dagi kun va data_type bo'yicha guruhlab min/max/avg/count
+// hisoblaydi. Natija dayKey formatidagi kun bo'yicha qaytariladi. Son qiymati
+// yo'q yozuvlar hisobga olinmaydi.
+func (h *Health) aggregateMetrics(ctx context.Context, collection, timeField, userID string, from, to time.Time, loc *time.Location) (map[string][]*pb.MetricSummary, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"user_id":    userID,
+			"deleted_at": nil,
+			timeField:    bson.M{"$gte": from, "$lt": to},
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			// measurement hali yozilmagan eski hujjatlar uchun data_value satri o'qiladi
+			"value": bson.M{"$ifNull": bson.A{
+				"$measurement.value",
+				bson.M{"$convert": bson.M{"input": "$data_value", "to": "double", "onError": nil, "onNull": nil}},
+			}},
+			"day": bson.M{"$dateToString": bson.M{
+				"date":     "$" + timeField,
+				"format":   "%Y-%m-%d",
+				"timezone": loc.String(),
+			}},
+		}}},
+		{{Key: "$match", Value: bson.M{"value": bson.M{"$ne": nil}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"day": "$day", "data_type": "$data_type"},
+			"min":   bson.M{"$min": "$value"},
+			"max":   bson.M{"$max": "$value"},
+			"avg":   bson.M{"$avg": "$value"},
+			"count": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id.day", Value: 1}, {Key: "_id.data_type", Value: 1}}}},
+	}
+
+	cursor, err := h.Db.Collection(collection).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	metrics := make(map[string][]*pb.MetricSummary)
+	for cursor.Next(ctx) {
+		var row struct {
+			Id struct {
+				Day      string `bson:"day"`
+				DataType string `bson:"data_type"`
+			} `bson:"_id"`
+			Min   float64 `bson:"min"`
+			Max   float64 `bson:"max"`
+			Avg   float64 `bson:"avg"`
+			Count int64   `bson:"count"`
+		}
+		if err := cursor.Decode(&row); err != nil {
+			return nil, err
+		}
+		metrics[row.Id.Day] = append(metrics[row.Id.Day], &pb.MetricSummary{
+			Source:   collection,
+			DataType: row.Id.DataType,
+			Min:      row.Min,
+			Max:      row.Max,
+			Avg:      row.Avg,
+			Count:    row.Count,
+		})
+	}
+
+	return metrics, cursor.Err()
+}
+
+// collectMetrics barcha summarySources bo'yicha aggregateMetrics natijalarini birlashtiradi
+func (h *Health) collectMetrics(ctx context.Context, userID string, from, to time.Time, loc *time.Location) (map[string][]*pb.MetricSummary, error) {
+	all := make(map[string][]*pb.MetricSummary)
+	for _, src := range summarySources {
+		metrics, err := h.aggregateMetrics(ctx, src.Collection, src.TimeField, userID, from, to, loc)
+		if err != nil {
+			h.Logger.Error("Failed to aggregate metrics", "collection", src.Collection, "error", err)
+			return nil, err
+		}
+		for day, m := range metrics {
+			all[day] = append(all[day], m...)
+		}
+	}
+	return all, nil
+}
+
+// recommendationsFor [from, to) oralig'ida yaratilgan tavsiyalarni qaytaradi
+func (h *Health) recommendationsFor(ctx context.Context, userID string, from, to time.Time) ([]*pb.HealthRecommendation, error) {
+	cursor, err := h.Db.Collection("health").Find(ctx,
+		bson.M{"user_id": userID, "deleted_at": nil, "created_at": bson.M{"$gte": from, "$lt": to}},
+		options.Find().SetSort(bson.D{{Key: "priority", Value: 1}, {Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []healthRecommendationDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	recommendations := make([]*pb.HealthRecommendation, 0, len(docs))
+	for _, doc := range docs {
+		recommendations = append(recommendations, doc.toProto())
+	}
+	return recommendations, nil
+}
+
+// userName ism-familiyani auth servisidan oladi. Auth servisi javob bermasa
+// xulosa baribir qaytariladi, faqat ism bo'sh qoladi.
+func (h *Health) userName(ctx context.Context, userID string) (string, string) {
+	if h.Auth == nil {
+		return "", ""
+	}
+
+	profile, err := h.Auth.GetUserProfile(ctx, &authpb.GetUserProfileRequest{UserId: userID})
+	if err != nil {
+		h.Logger.Warn("Failed to get user profile from auth service", "user_id", userID, "error", err)
+		return "", ""
+	}
+	return profile.FirstName, profile.LastName
+}
+
+// GetDailyHealthSummary kunlik xulosa: wearable_data va lifestyle_data dagi shu
+// kunga tegishli o'lchovlar statistikasi va shu kuni yaratilgan tavsiyalar. Kun
+// foydalanuvchining vaqt zonasida hisoblanadi.
+func (h *Health) GetDailyHealthSummary(ctx context.Context, req *pb.GetDailyHealthSummaryRequest) (*pb.GetDailyHealthSummaryResponse, error) {
+	loc := h.location(ctx, req.UserId)
+	day, err := parseSummaryDate(req.Date, loc)
+	if err != nil {
+		return nil, err
+	}
+	next := day.AddDate(0, 0, 1)
+
+	summary := &pb.GetDailyHealthSummaryResponse{Date: day.Format("2006/01/02")}
+
+	metrics, err := h.collectMetrics(ctx, req.UserId, day, next, loc)
+	if err != nil {
+		return nil, err
+	}
+	summary.Metrics = metrics[day.Format(dayKey)]
+
+	summary.Recommendations, err = h.recommendationsFor(ctx, req.UserId, day, next)
+	if err != nil {
+		h.Logger.Error("Failed to get daily recommendations", "error", err)
+		return nil, err
+	}
+
+	summary.FirstName, summary.LastName = h.userName(ctx, req.UserId)
+
+	if err := h.audit(ctx, auditGet, "health_summary", "", req.UserId); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// trendThreshold shundan kichik nisbiy o'zgarish stable deb hisoblanadi
+const trendThreshold = 0.05
+
+// GetWeeklyHealthSummary start_date dan boshlanadigan 7 kunlik xulosa: har kun
+// uchun ko'rsatkichlar, har bir ko'rsatkichning hafta ichidagi yo'nalishi va
+// oldingi 7 kunga nisbatan o'zgarishi. Kunlar foydalanuvchining vaqt zonasida
+// hisoblanadi, shuning uchun soat o'zgaradigan kunlar 23 yoki 25 soat bo'lishi mumkin.
+func (h *Health) GetWeeklyHealthSummary(ctx context.Context, req *pb.GetWeeklyHealthSummaryRequest) (*pb.GetWeeklyHealthSummaryResponse, error) {
+	loc := h.location(ctx, req.UserId)
+	start, err := parseSummaryDate(req.StartDate, loc)
+	if err != nil {
+		return nil, err
+	}
+	end := start.AddDate(0, 0, 7)
+
+	// Oldingi hafta ham bitta so'rovda olinadi
+	days := make([]time.Time, 14)
+	for i := range days {
+		days[i] = start.AddDate(0, 0, i-7)
+	}
+	previousWeek, week := days[:7], days[7:]
+
+	metrics, err := h.collectMetrics(ctx, req.UserId, days[0], end, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &pb.GetWeeklyHealthSummaryResponse{
+		StartDate: week[0].Format("2006/01/02"),
+		EndDate:   week[6].Format("2006/01/02"),
+	}
+
+	for _, day := range week {
+		summary.Days = append(summary.Days, &pb.DailyMetrics{
+			Date:    day.Format("2006/01/02"),
+			Metrics: metrics[day.Format(dayKey)],
+		})
+	}
+
+	summary.Trends = weeklyTrends(metrics, week, previousWeek)
+
+	summary.Health, err = h.recommendationsFor(ctx, req.UserId, start, end)
+	if err != nil {
+		h.Logger.Error("Failed to get weekly recommendations", "error", err)
+		return nil, err
+	}
+
+	if err := h.audit(ctx, auditGet, "health_summary", "", req.UserId); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+type metricKey struct {
+	Source   string
+	DataType string
+}
+
+// weeklyTrends har bir ko'rsatkich uchun haftalik o'rtachani, kunlik
+// o'rtachalar regressiyasi bo'yicha yo'nalishni va oldingi haftaga nisbatan
+// o'zgarishni hisoblaydi
+func weeklyTrends(metrics map[string][]*pb.MetricSummary, week, previousWeek []time.Time) []*pb.MetricTrend {
+	type series struct {
+		x, y       []float64
+		sum, count float64
+	}
+	current := make(map[metricKey]*series)
+	var keys []metricKey
+	for i, day := range week {
+		for _, m := range metrics[day.Format(dayKey)] {
+			key := metricKey{m.Source, m.DataType}
+			s, ok := current[key]
+			if !ok {
+				s = &series{}
+				current[key] = s
+				keys = append(keys, key)
+			}
+			s.x = append(s.x, float64(i))
+			s.y = append(s.y, m.Avg)
+			s.sum += m.Avg * float64(m.Count)
+			s.count += float64(m.Count)
+		}
+	}
+
+	previous := make(map[metricKey][2]float64)
+	for _, day := range previousWeek {
+		for _, m := range metrics[day.Format(dayKey)] {
+			key := metricKey{m.Source, m.DataType}
+			p := previous[key]
+			previous[key] = [2]float64{p[0] + m.Avg*float64(m.Count), p[1] + float64(m.Count)}
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Source != keys[j].Source {
+			return keys[i].Source < keys[j].Source
+		}
+		return keys[i].DataType < keys[j].DataType
+	})
+
+	trends := make([]*pb.MetricTrend, 0, len(keys))
+	for _, key := range keys {
+		s := current[key]
+		trend := &pb.MetricTrend{
+			Source:    key.Source,
+			DataType:  key.DataType,
+			Avg:       s.sum / s.count,
+			Direction: trendDirection(s.x, s.y),
+		}
+		if p := previous[key]; p[1] > 0 {
+			trend.PreviousAvg = p[0] / p[1]
+			if trend.PreviousAvg != 0 {
+				trend.ChangePercent = (trend.Avg - trend.PreviousAvg) / math.Abs(trend.PreviousAvg) * 100
+			}
+		}
+		trends = append(trends, trend)
+	}
+
+	return trends
+}
+
+// trendDirection kunlik o'rtachalarga eng kichik kvadratlar chizig'ini
+// o'tkazadi. Hafta davomidagi o'zgarish o'rtachaning trendThreshold qismidan
+// kichik bo'lsa stable qaytariladi.
+func trendDirection(x, y []float64) string {
+	n := float64(len(x))
+	if n < 2 {
+		return "stable"
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for i := range x {
+		sumX += x[i]
+		sumY += y[i]
+		sumXY += x[i] * y[i]
+		sumXX += x[i] * x[i]
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return "stable"
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+
+	mean := sumY / n
+	change := slope * (x[len(x)-1] - x[0])
+	if mean != 0 {
+		change /= math.Abs(mean)
+	}
+
+	switch {
+	case change > trendThreshold:
+		return "up"
+	case change < -trendThreshold:
+		return "down"
+	default:
+		return "stable"
+	}
+}
@@ -0,0 +1,141 @@
+package mongoDb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	pb "health/genproto/health_analytics"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrendDirection(t *testing.T) {
+	tests := []struct {
+		name string
+		y    []float64
+		want string
+	}{
+		{"single day", []float64{70}, "stable"},
+		{"flat", []float64{70, 70, 70, 70}, "stable"},
+		{"small noise", []float64{70, 71, 69, 70, 71}, "stable"},
+		{"rising", []float64{6000, 7000, 8000, 9000}, "up"},
+		{"falling", []float64{9, 8, 7, 6}, "down"},
+		{"negative values rising", []float64{-10, -8, -6}, "up"},
+		{"zero mean", []float64{-1, 0, 1}, "up"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := make([]float64, len(tt.y))
+			for i := range x {
+				x[i] = float64(i)
+			}
+			if got := trendDirection(x, tt.y); got != tt.want {
+				t.Errorf("trendDirection(%v) = %q, want %q", tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeeklyTrends(t *testing.T) {
+	start := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	days := make([]time.Time, 14)
+	for i := range days {
+		days[i] = start.AddDate(0, 0, i-7)
+	}
+	previousWeek, week := days[:7], days[7:]
+
+	metric := func(dataType string, avg float64, count int64) *pb.MetricSummary {
+		return &pb.MetricSummary{Source: "wearable_data", DataType: dataType, Avg: avg, Count: count}
+	}
+	metrics := map[string][]*pb.MetricSummary{
+		// heart_rate: oldingi hafta 60, bu hafta vaznli o'rtacha 66
+		previousWeek[0].Format(dayKey): {metric("heart_rate", 60, 2)},
+		week[0].Format(dayKey):         {metric("heart_rate", 60, 1), metric("steps", 4000, 1)},
+		week[6].Format(dayKey):         {metric("heart_rate", 68, 3)},
+		// spo2 oldingi haftada 0: foiz hisoblanmaydi
+		previousWeek[3].Format(dayKey): {metric("spo2", 0, 1)},
+		week[2].Format(dayKey):         {metric("spo2", 97, 1)},
+	}
+
+	trends := weeklyTrends(metrics, week, previousWeek)
+
+	tests := []struct {
+		dataType      string
+		avg           float64
+		previousAvg   float64
+		changePercent float64
+		direction     string
+	}{
+		{"heart_rate", 66, 60, 10, "up"},
+		{"spo2", 97, 0, 0, "stable"},
+		{"steps", 4000, 0, 0, "stable"},
+	}
+	if len(trends) != len(tests) {
+		t.Fatalf("weeklyTrends() returned %d trends, want %d", len(trends), len(tests))
+	}
+	for i, tt := range tests {
+		got := trends[i]
+		if got.DataType != tt.dataType {
+			t.Fatalf("trends[%d].DataType = %q, want %q (sorted by source, data_type)", i, got.DataType, tt.dataType)
+		}
+		if math.Abs(got.Avg-tt.avg) > 1e-9 || math.Abs(got.PreviousAvg-tt.previousAvg) > 1e-9 ||
+			math.Abs(got.ChangePercent-tt.changePercent) > 1e-9 || got.Direction != tt.direction {
+			t.Errorf("%s trend = avg %v prev %v change %v %s, want avg %v prev %v change %v %s", tt.dataType,
+				got.Avg, got.PreviousAvg, got.ChangePercent, got.Direction,
+				tt.avg, tt.previousAvg, tt.changePercent, tt.direction)
+		}
+	}
+}
+
+func TestWeeklyTrendsNegativeBaseline(t *testing.T) {
+	week := []time.Time{time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)}
+	previousWeek := []time.Time{time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)}
+	metrics := map[string][]*pb.MetricSummary{
+		previousWeek[0].Format(dayKey): {{Source: "lifestyle_data", DataType: "balance", Avg: -200, Count: 1}},
+		week[0].Format(dayKey):         {{Source: "lifestyle_data", DataType: "balance", Avg: -100, Count: 1}},
+	}
+	trends := weeklyTrends(metrics, week, previousWeek)
+	if len(trends) != 1 || trends[0].ChangePercent != 50 {
+		t.Errorf("weeklyTrends() = %v, want change_percent 50 for -200 -> -100", trends)
+	}
+}
+
+func TestParseSummaryDate(t *testing.T) {
+	tashkent, err := time.LoadLocation("Asia/Tashkent")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		loc     *time.Location
+		want    time.Time
+		wantErr bool
+	}{
+		{"slashes", "2026/03/09", time.UTC, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), false},
+		{"dashes", "2026-03-09", time.UTC, time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), false},
+		{"local midnight", "2026-03-09", tashkent, time.Date(2026, 3, 8, 19, 0, 0, 0, time.UTC), false},
+		{"with time", "2026-03-09T10:00:00Z", time.UTC, time.Time{}, true},
+		{"empty", "", time.UTC, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSummaryDate(tt.value, tt.loc)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Errorf("parseSummaryDate() error = %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("parseSummaryDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package mongoDb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"health/pkg/timezone"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// now hozirgi vaqt BSON Date aniqligida (millisekund). Shunda yozilgan va
+// javobda qaytgan vaqt, cursor dagi vaqt ham bazadagisi bilan bir xil bo'ladi.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// protoTime nol vaqt uchun nil qaytaradi
+func protoTime(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// protoTimePtr deleted_at, revoked_at kabi bo'sh bo'lishi mumkin maydonlar uchun
+func protoTimePtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return protoTime(*t)
+}
+
+// requiredTime so'rovdagi majburiy vaqt maydonini o'qiydi
+func requiredTime(ts *timestamppb.Timestamp, field string) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s kiritilmagan", field)
+	}
+	if err := ts.CheckValid(); err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "%s noto'g'ri: %v", field, err)
+	}
+	return ts.AsTime().Truncate(time.Millisecond), nil
+}
+
+// timeRange filtrga field uchun [from, to) oralig'ini qo'shadi. Berilmagan
+// chegara qo'yilmaydi.
+func timeRange(filter bson.M, field string, from, to *timestamppb.Timestamp) error {
+	cond := bson.M{}
+	for op, ts := range map[string]*timestamppb.Timestamp{"$gte": from, "$lt": to} {
+		if ts == nil {
+			continue
+		}
+		if err := ts.CheckValid(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "from va to noto'g'ri: %v", err)
+		}
+		cond[op] = ts.AsTime()
+	}
+	if len(cond) > 0 {
+		filter[field] = cond
+	}
+	return nil
+}
+
+// location kunlik hisoblar uchun vaqt zonasi: so'rovdagi x-timezone, u
+// berilmagan bo'lsa userID ning saqlangan zonasi, u ham bo'lmasa UTC. Saqlangan
+// zonani o'qib bo'lmasa xatolik qaytmaydi, UTC ishlatiladi.
+func (h *Health) location(ctx context.Context, userID string) *time.Location {
+	if loc, ok := timezone.FromContext(ctx); ok {
+		return loc
+	}
+	if userID == "" {
+		return time.UTC
+	}
+	settings, err := h.userSettings(ctx, userID)
+	if err != nil {
+		h.Logger.Warn("Failed to read user timezone, using UTC", "user_id", userID, "error", err)
+		return time.UTC
+	}
+	if settings.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := timezone.Load(settings.Timezone)
+	if err != nil {
+		h.Logger.Warn("Stored user timezone is invalid, using UTC", "user_id", userID, "timezone", settings.Timezone, "error", err)
+		return time.UTC
+	}
+	return loc
+}
+
+// startOfDay t ning loc dagi kuni boshlanishi
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// dateFields satr ko'rinishida yozilgan va BSON Date ga o'tkaziladigan vaqt
+// maydonlari. audit_log.timestamp hash ga kirgani uchun bu yerda yo'q.
+var dateFields = []struct {
+	Collection string
+	Fields     []string
+}{
+	{"medical_records", []string{"record_date", "created_at", "updated_at", "deleted_at"}},
+	{"lifestyle_data", []string{"recorded_date", "created_at", "updated_at", "deleted_at"}},
+	{"wearable_data", []string{"recorded_timestamp", "created_at", "updated_at", "deleted_at"}},
+	{"health", []string{"created_at", "updated_at", "deleted_at"}},
+	{"alerts", []string{"created_at", "acknowledged_at"}},
+	{"alert_thresholds", []string{"created_at", "updated_at", "deleted_at"}},
+	{"doctor_consents", []string{"created_at", "revoked_at"}},
+	{"data_keys", []string{"created_at"}},
+	{migrationsCollection, []string{"applied_at"}},
+}
+
+// toDateExpr satr vaqtni Date ga o'tkazadigan ifoda. "0" va bo'sh satr (faol
+// hujjat belgisi) null bo'ladi. Faqat sanadan iborat qiymatlar UTC bo'yicha kun
+// o'rtasi deb olinadi: shunda sana UTC-12 dan UTC+11 gacha bo'lgan har qanday
+// zonada o'sha kunga tushadi. O'qib bo'lmaydigan satr ham null bo'ladi.
+func toDateExpr(value string) bson.M {
+	return bson.M{"$switch": bson.M{
+		"branches": bson.A{
+			bson.M{"case": bson.M{"$eq": bson.A{bson.M{"$type": value}, "date"}}, "then": value},
+			bson.M{"case": bson.M{"$ne": bson.A{bson.M{"$type": value}, "string"}}, "then": nil},
+			bson.M{"case": bson.M{"$in": bson.A{value, bson.A{"", "0"}}}, "then": nil},
+			bson.M{
+				"case": bson.M{"$regexMatch": bson.M{"input": value, "regex": `^\d{4}[-/]\d{2}[-/]\d{2}$`}},
+				"then": bson.M{"$dateFromString": bson.M{
+					"dateString": bson.M{"$concat": bson.A{
+						bson.M{"$replaceAll": bson.M{"input": value, "find": "/", "replacement": "-"}},
+						"T12:00:00Z",
+					}},
+					"onError": nil,
+				}},
+			},
+		},
+		"default": bson.M{"$dateFromString": bson.M{"dateString": value, "onError": nil}},
+	}}
+}
+
+// unparsedDateExpr o'qib bo'lmaydigan satr bo'lsa asl qiymatni, aks holda
+// $$REMOVE ni qaytaradi
+func unparsedDateExpr(value string) bson.M {
+	return bson.M{"$cond": bson.A{
+		bson.M{"$and": bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$type": value}, "string"}},
+			bson.M{"$not": bson.A{bson.M{"$in": bson.A{value, bson.A{"", "0"}}}}},
+			bson.M{"$eq": bson.A{toDateExpr(value), nil}},
+		}},
+		value,
+		"$$REMOVE",
+	}}
+}
+
+// convertDatesToBSON dateFields dagi satr vaqtlarni va tibbiy yozuv
+// fayllaridagi uploaded_at ni BSON Date ga o'tkazadi. O'qib bo'lmaydigan
+// qiymatlar yo'qolmasligi uchun <field>_unparsed maydoniga ko'chiriladi.
+// Faol rozilik endi revoked_at null bilan belgilangani uchun rozilik unique
+// index i shu shart bilan qayta yaratiladi.
+func convertDatesToBSON(ctx context.Context, db *mongo.Database, log *slog.Logger) error {
+	for _, c := range dateFields {
+		stringFields := make(bson.A, 0, len(c.Fields))
+		unparsed := bson.M{}
+		converted := bson.M{}
+		for _, f := range c.Fields {
+			stringFields = append(stringFields, bson.M{f: bson.M{"$type": "string"}})
+			unparsed[f+"_unparsed"] = unparsedDateExpr("$" + f)
+			converted[f] = toDateExpr("$" + f)
+		}
+
+		result, err := db.Collection(c.Collection).UpdateMany(ctx,
+			bson.M{"$or": stringFields},
+			mongo.Pipeline{
+				{{Key: "$set", Value: unparsed}},
+				{{Key: "$set", Value: converted}},
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to convert dates in %s: %v", c.Collection, err)
+		}
+		for _, f := range c.Fields {
+			n, err := db.Collection(c.Collection).CountDocuments(ctx, bson.M{f + "_unparsed": bson.M{"$exists": true}})
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				log.Warn("Unparseable dates set to null", "collection", c.Collection, "field", f, "count", n, "kept_in", f+"_unparsed")
+			}
+		}
+		log.Info("Converted dates to BSON", "collection", c.Collection, "modified", result.ModifiedCount)
+	}
+
+	result, err := db.Collection("medical_records").UpdateMany(ctx,
+		bson.M{"attachments.uploaded_at": bson.M{"$type": "string"}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"attachments": bson.M{"$map": bson.M{
+				"input": "$attachments",
+				"as":    "a",
+				"in": bson.M{"$mergeObjects": bson.A{
+					"$$a",
+					bson.M{"uploaded_at": toDateExpr("$$a.uploaded_at")},
+				}},
+			}},
+		}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to convert attachment dates: %v", err)
+	}
+	log.Info("Converted attachment dates to BSON", "modified", result.ModifiedCount)
+
+	consents := db.Collection("doctor_consents")
+	if _, err := consents.Indexes().DropOne(ctx, "patient_id_1_doctor_id_1"); err != nil {
+		var cmdErr mongo.CommandError
+		if !errors.As(err, &cmdErr) || cmdErr.Name != "IndexNotFound" {
+			return fmt.Errorf("failed to drop doctor consent index: %v", err)
+		}
+	}
+	name, err := consents.Indexes().CreateOne(ctx, activeConsentIndex.model())
+	if err != nil {
+		return err
+	}
+	log.Info("Recreated doctor consent index", "index", name)
+	return nil
+}
@@ -0,0 +1,89 @@
+package mongoDb
+
+import (
+	"fmt"
+
+	"health/config"
+
+	"github.com/streadway/amqp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func retryQueue(queue string) string      { return queue + ".retry" }
+func deadLetterQueue(queue string) string { return queue + ".dlq" }
+
+// consumedQueues servis o'qiydigan navbatlar
+func (h *Health) consumedQueues() []config.QueueConfig {
+	return []config.QueueConfig{h.Topology.WearableData, h.Topology.HealthRecommendations}
+}
+
+func (h *Health) validateConsumedQueue(queue string) error {
+	for _, q := range h.consumedQueues() {
+		if q.Name == queue {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "noma'lum navbat: %q", queue)
+}
+
+// DeclareTopology Topology dagi exchange va navbatlarni e'lon qiladi. Har bir
+// o'qiladigan navbat uchun:
+//   - navbatning o'zi, rad etilgan yoki MessageTTL dan oshgan xabarlar dead-letter
+//     exchange orqali "<queue>.dlq" ga tushadi
+//   - "<queue>.dlq" dead-letter exchange ga navbat nomi bilan bog'lanadi
+//   - "<queue>.retry", unda muddati o'tgan xabar default exchange orqali asl
+//     navbatga qaytadi
+//
+// Hammasi idempotent va har qayta ulanishda chaqiriladi. Navbat oldin boshqa
+// argumentlar bilan yaratilgan bo'lsa broker PRECONDITION_FAILED qaytaradi: uni
+// o'chirib yoki policy orqali moslab, servisni qayta ishga tushirish kerak.
+func (h *Health) DeclareTopology(ch *amqp.Channel) error {
+	exchanges := map[string]string{
+		h.Topology.AlertsExchange:     "topic",
+		h.Topology.DeadLetterExchange: "direct",
+	}
+	for name, kind := range exchanges {
+		err := ch.ExchangeDeclare(
+			name,  // Exchange nomi
+			kind,  // Turi
+			true,  // Durable
+			false, // Auto-delete
+			false, // Internal
+			false, // No-wait
+			nil,   // Arguments
+		)
+		if err != nil {
+			return fmt.Errorf("failed to declare %s exchange: %v", name, err)
+		}
+	}
+
+	for _, q := range h.consumedQueues() {
+		args := amqp.Table{
+			"x-dead-letter-exchange":    h.Topology.DeadLetterExchange,
+			"x-dead-letter-routing-key": q.Name,
+		}
+		if q.MessageTTL > 0 {
+			args["x-message-ttl"] = q.MessageTTL.Milliseconds()
+		}
+		if _, err := ch.QueueDeclare(q.Name, true, false, false, false, args); err != nil {
+			return fmt.Errorf("failed to declare %s (if it already exists with other arguments, delete it or align it with a policy): %v", q.Name, err)
+		}
+
+		if _, err := ch.QueueDeclare(deadLetterQueue(q.Name), true, false, false, false, nil); err != nil {
+			return fmt.Errorf("failed to declare %s: %v", deadLetterQueue(q.Name), err)
+		}
+		if err := ch.QueueBind(deadLetterQueue(q.Name), q.Name, h.Topology.DeadLetterExchange, false, nil); err != nil {
+			return fmt.Errorf("failed to bind %s: %v", deadLetterQueue(q.Name), err)
+		}
+
+		_, err := ch.QueueDeclare(retryQueue(q.Name), true, false, false, false, amqp.Table{
+			"x-dead-letter-exchange":    "",
+			"x-dead-letter-routing-key": q.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to declare %s: %v", retryQueue(q.Name), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package mongoDb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "health/genproto/health_analytics"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// trashCollections soft delete qilinadigan va tozalash jobi ko'radigan kolleksiyalar
+var trashCollections = []string{"medical_records", "lifestyle_data", "wearable_data"}
+
+// trashDoc o'chirilganlar ro'yxatida sahifalash uchun saralash kalitlari
+type trashDoc interface {
+	trashKey() (deletedAt time.Time, id string)
+}
+
+func (d medicalRecordDoc) trashKey() (time.Time, string) { return deletedKey(d.DeletedAt), d.Id }
+func (d lifestyleDataDoc) trashKey() (time.Time, string) { return deletedKey(d.DeletedAt), d.Id }
+func (d wearableDataDoc) trashKey() (time.Time, string)  { return deletedKey(d.DeletedAt), d.Id }
+
+// deletedKey ro'yxatdagi hujjatlarda deleted_at doim bor, nil faqat himoya uchun
+func deletedKey(deletedAt *time.Time) time.Time {
+	if deletedAt == nil {
+		return time.Time{}
+	}
+	return *deletedAt
+}
+
+type listDeletedCursor struct {
+	DeletedAt time.Time `json:"d"`
+	Id        string    `json:"i"`
+}
+
+// listDeleted foydalanuvchining kolleksiyadagi o'chirilgan hujjatlarini
+// deleted_at (kamayish) bo'yicha sahifalab qaytaradi
+func listDeleted[T trashDoc](ctx context.Context, coll *mongo.Collection, userID string, limit int64, cursorToken string) ([]T, string, error) {
+	if userID == "" {
+		return nil, "", status.Error(codes.InvalidArgument, "user_id kiritilishi shart")
+	}
+
+	filter := bson.M{"user_id": userID, "deleted_at": bson.M{"$ne": nil}}
+	if cursorToken != "" {
+		var c listDeletedCursor
+		if err := decodeCursor(cursorToken, &c); err != nil {
+			return nil, "", err
+		}
+		filter["$or"] = []bson.M{
+			{"deleted_at": bson.M{"$lt": c.DeletedAt}},
+			{"deleted_at": c.DeletedAt, "id": bson.M{"$lt": c.Id}},
+		}
+	}
+
+	limit = pageSize(limit)
+	cursor, err := coll.Find(ctx, filter,
+		options.Find().
+			SetSort(bson.D{{Key: "deleted_at", Value: -1}, {Key: "id", Value: -1}}).
+			SetLimit(limit+1),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []T
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		deletedAt, id := docs[len(docs)-1].trashKey()
+		if next, err = encodeCursor(listDeletedCursor{DeletedAt: deletedAt, Id: id}); err != nil {
+			return nil, "", err
+		}
+	}
+	return docs, next, nil
+}
+
+// restoreDeleted o'chirilgan hujjatni qayta faollashtiradi. Hujjat topilmasa,
+// boshqa foydalanuvchiga tegishli bo'lsa yoki o'chirilmagan bo'lsa false qaytadi.
+func restoreDeleted(ctx context.Context, coll *mongo.Collection, id, userID string) (bool, error) {
+	if id == "" || userID == "" {
+		return false, status.Error(codes.InvalidArgument, "id va user_id kiritilishi shart")
+	}
+
+	result, err := coll.UpdateOne(ctx,
+		bson.M{"id": id, "user_id": userID, "deleted_at": bson.M{"$ne": nil}},
+		bson.M{"$set": bson.M{"deleted_at": nil, "updated_at": now()}},
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// RestoreMedicalRecord o'chirilgan tibbiy yozuvni qaytaradi
+func (h *Health) RestoreMedicalRecord(ctx context.Context, req *pb.RestoreMedicalRecordRequest) (*pb.RestoreMedicalRecordResponse, error) {
+	restored, err := restoreDeleted(ctx, h.Db.Collection("medical_records"), req.Id, req.UserId)
+	if err != nil {
+		h.Logger.Error("Failed to restore medical record", "error", err)
+		return nil, err
+	}
+
+	if !restored {
+		h.Logger.Warn("Deleted medical record not found for restore", "record_id", req.Id, "user_id", req.UserId)
+		return &pb.RestoreMedicalRecordResponse{Success: false}, errors.New("o'chirilgan tibbiy yozuv topilmadi")
+	}
+
+	if err := h.audit(ctx, auditRestore, "medical_records", req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreMedicalRecordResponse{Success: true}, nil
+}
+
+// ListDeletedMedicalRecords foydalanuvchining o'chirilgan tibbiy yozuvlari
+func (h *Health) ListDeletedMedicalRecords(ctx context.Context, req *pb.ListDeletedMedicalRecordsRequest) (*pb.ListDeletedMedicalRecordsResponse, error) {
+	docs, next, err := listDeleted[medicalRecordDoc](ctx, h.Db.Collection("medical_records"), req.UserId, req.Limit, req.Cursor)
+	if err != nil {
+		h.Logger.Error("Failed to list deleted medical records", "user_id", req.UserId, "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListDeletedMedicalRecordsResponse{NextCursor: next}
+	for _, d := range docs {
+		if err := h.decryptRecord(ctx, &d); err != nil {
+			return nil, err
+		}
+		resp.MedicalRecords = append(resp.MedicalRecords, d.toProto())
+	}
+
+	if err := h.audit(ctx, auditList, "medical_records", "", req.UserId); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RestoreLifestyleData o'chirilgan turmush tarzi ma'lumotini qaytaradi
+func (h *Health) RestoreLifestyleData(ctx context.Context, req *pb.RestoreLifestyleDataRequest) (*pb.RestoreLifestyleDataResponse, error) {
+	restored, err := restoreDeleted(ctx, h.Db.Collection("lifestyle_data"), req.Id, req.UserId)
+	if err != nil {
+		h.Logger.Error("Failed to restore lifestyle data", "error", err)
+		return nil, err
+	}
+
+	if !restored {
+		h.Logger.Warn("Deleted lifestyle data not found for restore", "id", req.Id, "user_id", req.UserId)
+		return &pb.RestoreLifestyleDataResponse{Success: false}, errors.New("o'chirilgan turmush tarzi ma'lumotlari topilmadi")
+	}
+
+	if err := h.audit(ctx, auditRestore, "lifestyle_data", req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreLifestyleDataResponse{Success: true}, nil
+}
+
+// ListDeletedLifestyleData foydalanuvchining o'chirilgan turmush tarzi ma'lumotlari
+func (h *Health) ListDeletedLifestyleData(ctx context.Context, req *pb.ListDeletedLifestyleDataRequest) (*pb.ListDeletedLifestyleDataResponse, error) {
+	docs, next, err := listDeleted[lifestyleDataDoc](ctx, h.Db.Collection("lifestyle_data"), req.UserId, req.Limit, req.Cursor)
+	if err != nil {
+		h.Logger.Error("Failed to list deleted lifestyle data", "user_id", req.UserId, "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListDeletedLifestyleDataResponse{NextCursor: next}
+	for _, d := range docs {
+		resp.LifestyleData = append(resp.LifestyleData, d.toProto())
+	}
+
+	if err := h.audit(ctx, auditList, "lifestyle_data", "", req.UserId); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RestoreWearableData o'chirilgan kiyiladigan qurilma ma'lumotini qaytaradi
+func (h *Health) RestoreWearableData(ctx context.Context, req *pb.RestoreWearableDataRequest) (*pb.RestoreWearableDataResponse, error) {
+	restored, err := restoreDeleted(ctx, h.Db.Collection("wearable_data"), req.Id, req.UserId)
+	if err != nil {
+		h.Logger.Error("Failed to restore wearable data", "error", err)
+		return nil, err
+	}
+
+	if !restored {
+		h.Logger.Warn("Deleted wearable data not found for restore", "id", req.Id, "user_id", req.UserId)
+		return &pb.RestoreWearableDataResponse{Success: false}, errors.New("o'chirilgan kiyiladigan qurilma ma'lumotlari topilmadi")
+	}
+
+	if err := h.audit(ctx, auditRestore, "wearable_data", req.Id, req.UserId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreWearableDataResponse{Success: true}, nil
+}
+
+// ListDeletedWearableData foydalanuvchining o'chirilgan kiyiladigan qurilma ma'lumotlari
+func (h *Health) ListDeletedWearableData(ctx context.Context, req *pb.ListDeletedWearableDataRequest) (*pb.ListDeletedWearableDataResponse, error) {
+	docs, next, err := listDeleted[wearableDataDoc](ctx, h.Db.Collection("wearable_data"), req.UserId, req.Limit, req.Cursor)
+	if err != nil {
+		h.Logger.Error("Failed to list deleted wearable data", "user_id", req.UserId, "error", err)
+		return nil, err
+	}
+
+	resp := &pb.ListDeletedWearableDataResponse{NextCursor: next}
+	for _, d := range docs {
+		resp.WearableData = append(resp.WearableData, d.toProto())
+	}
+
+	if err := h.audit(ctx, auditList, "wearable_data", "", req.UserId); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// PurgeDeleted retention dan oldin o'chirilgan hujjatlarni butunlay o'chiradi
+// va har bir kolleksiyadan nechta hujjat o'chirilganini qaytaradi
+func (h *Health) PurgeDeleted(ctx context.Context, retention time.Duration) (map[string]int64, error) {
+	cutoff := time.Now().Add(-retention)
+	purged := make(map[string]int64, len(trashCollections))
+
+	for _, collection := range trashCollections {
+		filter := bson.M{"deleted_at": bson.M{"$lt": cutoff}}
+
+		// Tibbiy yozuv fayllari GridFS da alohida turadi
+		if collection == "medical_records" {
+			if err := h.purgeAttachments(ctx, filter); err != nil {
+				return purged, err
+			}
+		}
+
+		result, err := h.Db.Collection(collection).DeleteMany(ctx, filter)
+		if err != nil {
+			return purged, err
+		}
+		purged[collection] = result.DeletedCount
+	}
+	return purged, nil
+}
+
+// RunPurgeJob PurgeDeleted ni har interval da ctx tugaguncha ishga tushiradi
+func (h *Health) RunPurgeJob(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := h.PurgeDeleted(ctx, retention)
+		if err != nil {
+			h.Logger.Error("Failed to purge deleted documents", "error", err)
+		}
+		for collection, n := range purged {
+			if n > 0 {
+				h.Logger.Info("Purged deleted documents", "collection", collection, "count", n)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	authpb "health/genproto/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	RolePatient = "patient"
+	RoleDoctor  = "doctor"
+	RoleAdmin   = "admin"
+	// RoleCompliance audit log ni ko'ra oladi, tibbiy ma'lumotlarni emas
+	RoleCompliance = "compliance"
+)
+
+// Principal tokeni tekshirilgan so'rov egasi
+type Principal struct {
+	UserID string
+	Role   string
+
+	consents ConsentChecker
+}
+
+// ConsentChecker bemor shifokorga o'z ma'lumotlarini ko'rishga rozilik
+// berganini tekshiradi
+type ConsentChecker interface {
+	HasConsent(ctx context.Context, patientID, doctorID string) (bool, error)
+}
+
+type principalKey struct{}
+
+// NewContext principal ni ctx ga qo'shadi
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext interceptor qo'ygan principal ni qaytaradi
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// IsAdmin admin barcha foydalanuvchilar ma'lumotlarini ko'ra oladi
+func (p Principal) IsAdmin() bool {
+	return p.Role == RoleAdmin
+}
+
+// Authorize so'rov egasi userID ning o'zi, admin yoki userID rozilik bergan
+// shifokor ekanini tekshiradi
+func Authorize(ctx context.Context, userID string) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "avtorizatsiyadan o'tilmagan")
+	}
+	if p.UserID == userID || p.IsAdmin() {
+		return nil
+	}
+
+	if p.Role == RoleDoctor && p.consents != nil && userID != "" {
+		granted, err := p.consents.HasConsent(ctx, userID, p.UserID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "rozilikni tekshirishda xatolik: %v", err)
+		}
+		if granted {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "bu foydalanuvchi ma'lumotlariga ruxsat yo'q")
+}
+
+// AuthorizeSelf faqat userID ning o'zi yoki admin ga ruxsat beradi. Rozilik
+// berish kabi amallarni shifokor bemor nomidan bajara olmaydi.
+func AuthorizeSelf(ctx context.Context, userID string) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "avtorizatsiyadan o'tilmagan")
+	}
+	if p.UserID == userID || p.IsAdmin() {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "bu amal uchun ruxsat yo'q")
+}
+
+// RequireRole so'rov egasi berilgan rollardan biriga ega ekanini tekshiradi
+func RequireRole(ctx context.Context, roles ...string) error {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "avtorizatsiyadan o'tilmagan")
+	}
+	for _, role := range roles {
+		if p.Role == role {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "bu amal uchun ruxsat yo'q")
+}
+
+// Validator bearer tokenni tekshiradi. JWTKey berilgan bo'lsa token lokal
+// tekshiriladi, aks holda auth servisining ValidateToken metodi chaqiriladi.
+type Validator struct {
+	JWTKey   []byte
+	Client   authpb.AuthServiceClient
+	Consents ConsentChecker
+}
+
+// claims auth servisi chiqaradigan tokendagi maydonlar
+type claims struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+func (v *Validator) Validate(ctx context.Context, token string) (Principal, error) {
+	p, err := v.validate(ctx, token)
+	if err != nil {
+		return Principal{}, err
+	}
+	p.consents = v.Consents
+	return p, nil
+}
+
+func (v *Validator) validate(ctx context.Context, token string) (Principal, error) {
+	if len(v.JWTKey) > 0 {
+		return v.validateLocal(token)
+	}
+	if v.Client == nil {
+		return Principal{}, errors.New("token tekshirish sozlanmagan")
+	}
+
+	resp, err := v.Client.ValidateToken(ctx, &authpb.ValidateTokenRequest{Token: token})
+	if err != nil {
+		return Principal{}, fmt.Errorf("auth servisida token tekshirilmadi: %v", err)
+	}
+	if !resp.Valid || resp.UserId == "" {
+		return Principal{}, errors.New("token yaroqsiz")
+	}
+	return Principal{UserID: resp.UserId, Role: resp.Role}, nil
+}
+
+func (v *Validator) validateLocal(token string) (Principal, error) {
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(t *jwt.Token) (interface{}, error) {
+		return v.JWTKey, nil
+	}, jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}))
+	if err != nil {
+		return Principal{}, err
+	}
+
+	userID := c.UserID
+	if userID == "" {
+		userID = c.Subject
+	}
+	if userID == "" {
+		return Principal{}, errors.New("tokenda user_id yo'q")
+	}
+	return Principal{UserID: userID, Role: strings.ToLower(c.Role)}, nil
+}
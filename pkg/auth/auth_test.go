@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeConsents patientID -> doctorID rozilik jadvali
+type fakeConsents struct {
+	granted map[[2]string]bool
+	err     error
+}
+
+func (f fakeConsents) HasConsent(ctx context.Context, patientID, doctorID string) (bool, error) {
+	return f.granted[[2]string{patientID, doctorID}], f.err
+}
+
+func TestAuthorize(t *testing.T) {
+	consents := fakeConsents{granted: map[[2]string]bool{{"patient-1", "doctor-1"}: true}}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		userID    string
+		want      codes.Code
+	}{
+		{"unauthenticated", nil, "patient-1", codes.Unauthenticated},
+		{"self", &Principal{UserID: "patient-1", Role: RolePatient}, "patient-1", codes.OK},
+		{"other patient", &Principal{UserID: "patient-2", Role: RolePatient}, "patient-1", codes.PermissionDenied},
+		{"admin", &Principal{UserID: "admin-1", Role: RoleAdmin}, "patient-1", codes.OK},
+		{"doctor with consent", &Principal{UserID: "doctor-1", Role: RoleDoctor, consents: consents}, "patient-1", codes.OK},
+		{"doctor without consent", &Principal{UserID: "doctor-2", Role: RoleDoctor, consents: consents}, "patient-1", codes.PermissionDenied},
+		{"doctor for empty user", &Principal{UserID: "doctor-1", Role: RoleDoctor, consents: consents}, "", codes.PermissionDenied},
+		{"doctor consent check fails", &Principal{UserID: "doctor-1", Role: RoleDoctor, consents: fakeConsents{err: errors.New("down")}}, "patient-1", codes.Internal},
+		{"compliance", &Principal{UserID: "auditor-1", Role: RoleCompliance}, "patient-1", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = NewContext(ctx, *tt.principal)
+			}
+			if got := status.Code(Authorize(ctx, tt.userID)); got != tt.want {
+				t.Errorf("Authorize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorizeSelf(t *testing.T) {
+	consents := fakeConsents{granted: map[[2]string]bool{{"patient-1", "doctor-1"}: true}}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		userID    string
+		want      codes.Code
+	}{
+		{"unauthenticated", nil, "patient-1", codes.Unauthenticated},
+		{"self", &Principal{UserID: "patient-1", Role: RolePatient}, "patient-1", codes.OK},
+		{"admin", &Principal{UserID: "admin-1", Role: RoleAdmin}, "patient-1", codes.OK},
+		{"doctor with consent", &Principal{UserID: "doctor-1", Role: RoleDoctor, consents: consents}, "patient-1", codes.PermissionDenied},
+		{"other patient", &Principal{UserID: "patient-2", Role: RolePatient}, "patient-1", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = NewContext(ctx, *tt.principal)
+			}
+			if got := status.Code(AuthorizeSelf(ctx, tt.userID)); got != tt.want {
+				t.Errorf("AuthorizeSelf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *Principal
+		roles     []string
+		want      codes.Code
+	}{
+		{"unauthenticated", nil, []string{RoleAdmin}, codes.Unauthenticated},
+		{"matching role", &Principal{UserID: "admin-1", Role: RoleAdmin}, []string{RoleAdmin}, codes.OK},
+		{"one of roles", &Principal{UserID: "auditor-1", Role: RoleCompliance}, []string{RoleAdmin, RoleCompliance}, codes.OK},
+		{"other role", &Principal{UserID: "doctor-1", Role: RoleDoctor}, []string{RoleAdmin}, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = NewContext(ctx, *tt.principal)
+			}
+			if got := status.Code(RequireRole(ctx, tt.roles...)); got != tt.want {
+				t.Errorf("RequireRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// userScoped so'rovda user_id bo'lsa interceptor egalikni o'zi tekshiradi.
+// Faqat id bilan keladigan so'rovlar uchun egalik Health metodlarida tekshiriladi.
+type userScoped interface {
+	GetUserId() string
+}
+
+// healthService gRPC health check lari token talab qilmaydi: ularni
+// orkestrator va load balancer chaqiradi
+const healthService = "/grpc.health.v1.Health/"
+
+func (v *Validator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization header kiritilmagan")
+	}
+
+	token, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found {
+		token, found = strings.CutPrefix(values[0], "bearer ")
+	}
+	if !found || token == "" {
+		return nil, status.Error(codes.Unauthenticated, "authorization header Bearer <token> ko'rinishida bo'lishi kerak")
+	}
+
+	p, err := v.Validate(ctx, token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "token yaroqsiz: %v", err)
+	}
+	return NewContext(ctx, p), nil
+}
+
+func authorizeRequest(ctx context.Context, req interface{}) error {
+	if r, ok := req.(userScoped); ok && r.GetUserId() != "" {
+		return Authorize(ctx, r.GetUserId())
+	}
+	return nil
+}
+
+// UnaryServerInterceptor tokenni tekshiradi va so'rovdagi user_id ga ruxsatni tekshiradi
+func (v *Validator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if strings.HasPrefix(info.FullMethod, healthService) {
+			return handler(ctx, req)
+		}
+		ctx, err := v.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := authorizeRequest(ctx, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor UnaryServerInterceptor ning oqimli RPC lar uchun varianti.
+// Mijozdan kelgan har bir xabardagi user_id tekshiriladi.
+func (v *Validator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if strings.HasPrefix(info.FullMethod, healthService) {
+			return handler(srv, ss)
+		}
+		ctx, err := v.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorizeRequest(s.ctx, m)
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testJWTKey = []byte("test-secret")
+
+// userRequest user_id li so'rov o'rnida
+type userRequest struct {
+	userID string
+}
+
+func (r userRequest) GetUserId() string { return r.userID }
+
+func signToken(t *testing.T, key []byte, c claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	patient := signToken(t, testJWTKey, claims{UserID: "patient-1", Role: "Patient"})
+	subjectOnly := signToken(t, testJWTKey, claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "patient-1"}})
+	noUser := signToken(t, testJWTKey, claims{Role: RolePatient})
+	foreign := signToken(t, []byte("other-secret"), claims{UserID: "patient-1"})
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		req           interface{}
+		want          codes.Code
+		wantUserID    string
+	}{
+		{"health check without token", "/grpc.health.v1.Health/Check", "", userRequest{}, codes.OK, ""},
+		{"missing header", "/health.HealthAnalyticsService/GetMedicalRecord", "", userRequest{}, codes.Unauthenticated, ""},
+		{"no bearer prefix", "/health.HealthAnalyticsService/GetMedicalRecord", patient, userRequest{}, codes.Unauthenticated, ""},
+		{"empty token", "/health.HealthAnalyticsService/GetMedicalRecord", "Bearer ", userRequest{}, codes.Unauthenticated, ""},
+		{"wrong signature", "/health.HealthAnalyticsService/GetMedicalRecord", "Bearer " + foreign, userRequest{}, codes.Unauthenticated, ""},
+		{"token without user", "/health.HealthAnalyticsService/GetMedicalRecord", "Bearer " + noUser, userRequest{}, codes.Unauthenticated, ""},
+		{"own user_id", "/health.HealthAnalyticsService/ListMedicalRecords", "Bearer " + patient, userRequest{"patient-1"}, codes.OK, "patient-1"},
+		{"lowercase bearer", "/health.HealthAnalyticsService/ListMedicalRecords", "bearer " + patient, userRequest{"patient-1"}, codes.OK, "patient-1"},
+		{"subject as user", "/health.HealthAnalyticsService/ListMedicalRecords", "Bearer " + subjectOnly, userRequest{"patient-1"}, codes.OK, "patient-1"},
+		{"other user_id", "/health.HealthAnalyticsService/ListMedicalRecords", "Bearer " + patient, userRequest{"patient-2"}, codes.PermissionDenied, ""},
+		{"request without user_id", "/health.HealthAnalyticsService/GetMedicalRecord", "Bearer " + patient, userRequest{}, codes.OK, "patient-1"},
+		{"request without GetUserId", "/health.HealthAnalyticsService/GetMedicalRecord", "Bearer " + patient, struct{}{}, codes.OK, "patient-1"},
+	}
+
+	v := &Validator{JWTKey: testJWTKey}
+	interceptor := v.UnaryServerInterceptor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			var gotUserID string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				if p, ok := FromContext(ctx); ok {
+					gotUserID = p.UserID
+				}
+				return nil, nil
+			}
+			_, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("interceptor error = %v, want %v", err, tt.want)
+			}
+			if gotUserID != tt.wantUserID {
+				t.Errorf("principal user = %q, want %q", gotUserID, tt.wantUserID)
+			}
+		})
+	}
+}
+
+func TestValidateLocalRole(t *testing.T) {
+	v := &Validator{JWTKey: testJWTKey}
+	p, err := v.Validate(context.Background(), signToken(t, testJWTKey, claims{UserID: "doctor-1", Role: "Doctor"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.UserID != "doctor-1" || p.Role != RoleDoctor {
+		t.Errorf("Validate() = %+v, want doctor-1/%s", p, RoleDoctor)
+	}
+}
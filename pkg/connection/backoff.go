@@ -0,0 +1,51 @@
+package connection
+
+import (
+	"context"
+	"log/slog"
+	"math/rand"
+	"time"
+)
+
+// Backoff qayta ulanishlar orasidagi eksponensial kutish chegaralari
+type Backoff struct {
+	Min time.Duration
+	Max time.Duration
+}
+
+// DefaultBackoff 500ms dan boshlab 30s gacha
+var DefaultBackoff = Backoff{Min: 500 * time.Millisecond, Max: 30 * time.Second}
+
+// Delay attempt-urinishdan keyingi kutish. Yarmi tasodifiy, shunda bir vaqtda
+// uzilgan replikalar brokerga birdaniga ulanmaydi.
+func (b Backoff) Delay(attempt int) time.Duration {
+	d := b.Min << attempt
+	if d <= 0 || d > b.Max {
+		d = b.Max
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// Retry fn muvaffaqiyatli bo'lguncha yoki ctx tugaguncha backoff bilan qayta chaqiradi
+func Retry(ctx context.Context, b Backoff, log *slog.Logger, name string, fn func(context.Context) error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			if attempt > 0 {
+				log.Info("Connected after retries", "connection", name, "attempts", attempt+1)
+			}
+			return nil
+		}
+
+		delay := b.Delay(attempt)
+		log.Warn("Connection attempt failed, retrying", "connection", name, "attempt", attempt+1, "retry_in", delay, "error", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
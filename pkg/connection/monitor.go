@@ -0,0 +1,84 @@
+package connection
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// checkTimeout bitta ping uchun vaqt chegarasi
+const checkTimeout = 5 * time.Second
+
+// Monitor ulanishlarni vaqti-vaqti bilan tekshirib holatini gRPC health
+// servisiga yozadi. Har bir ulanish o'z nomi bilan ("mongodb", "redis", ...),
+// umumiy holat esa bo'sh nom bilan: hamma ulanish ishlasagina SERVING.
+type Monitor struct {
+	server   *health.Server
+	log      *slog.Logger
+	interval time.Duration
+
+	mu     sync.Mutex
+	failed map[string]bool
+}
+
+func NewMonitor(server *health.Server, log *slog.Logger, interval time.Duration) *Monitor {
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Monitor{server: server, log: log, interval: interval, failed: make(map[string]bool)}
+}
+
+// Watch check ni ctx tugaguncha har interval da chaqiradi
+func (m *Monitor) Watch(ctx context.Context, name string, check func(context.Context) error) {
+	m.mu.Lock()
+	m.failed[name] = true
+	m.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(m.interval)
+		defer ticker.Stop()
+
+		for {
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			err := check(checkCtx)
+			cancel()
+			m.set(name, err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (m *Monitor) set(name string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if failed := err != nil; failed != m.failed[name] {
+		if failed {
+			m.log.Error("Connection is down", "connection", name, "error", err)
+		} else {
+			m.log.Info("Connection is up", "connection", name)
+		}
+	}
+	m.failed[name] = err != nil
+	m.server.SetServingStatus(name, servingStatus(err == nil))
+
+	healthy := true
+	for _, failed := range m.failed {
+		healthy = healthy && !failed
+	}
+	m.server.SetServingStatus("", servingStatus(healthy))
+}
+
+func servingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
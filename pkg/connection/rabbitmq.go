@@ -0,0 +1,187 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+// RabbitMQ broker bilan ulanishni boshqaradi. Ulanish yoki umumiy kanal
+// yopilsa backoff bilan qayta ulanadi va OnConnect hook larini qayta ishga
+// tushiradi. Consumer lar o'z kanallarini OpenChannel bilan ochadi va kanal
+// yopilganda qaytadan ochadi.
+type RabbitMQ struct {
+	url     string
+	log     *slog.Logger
+	backoff Backoff
+
+	hooks []func(*amqp.Channel) error
+
+	mu     sync.RWMutex
+	conn   *amqp.Connection
+	ch     *amqp.Channel
+	ready  chan struct{}
+	err    error
+	closed bool
+}
+
+func NewRabbitMQ(url string, log *slog.Logger) *RabbitMQ {
+	return &RabbitMQ{
+		url:     url,
+		log:     log,
+		backoff: DefaultBackoff,
+		ready:   make(chan struct{}),
+		err:     errors.New("hali ulanilmagan"),
+	}
+}
+
+// OnConnect har bir ulanishdan keyin umumiy kanal bilan chaqiriladi, masalan
+// exchange va queue larni e'lon qilish uchun. Start dan oldin qo'shilishi kerak.
+func (r *RabbitMQ) OnConnect(fn func(*amqp.Channel) error) {
+	r.hooks = append(r.hooks, fn)
+}
+
+// Start birinchi ulanishni timeout gacha kutadi, keyin ulanishni ctx tugaguncha
+// fonda kuzatadi
+func (r *RabbitMQ) Start(ctx context.Context, timeout time.Duration) error {
+	startCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err := Retry(startCtx, r.backoff, r.log, "rabbitmq", r.connect); err != nil {
+		return err
+	}
+	go r.watch(ctx)
+	return nil
+}
+
+func (r *RabbitMQ) connect(ctx context.Context) error {
+	conn, err := amqp.Dial(r.url)
+	if err != nil {
+		return fmt.Errorf("failed to connect to RabbitMQ: %v", err)
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to open a channel: %v", err)
+	}
+	for _, hook := range r.hooks {
+		if err := hook(ch); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		conn.Close()
+		return nil
+	}
+	r.conn, r.ch, r.err = conn, ch, nil
+	close(r.ready)
+	r.mu.Unlock()
+
+	r.log.Info("Connected to RabbitMQ")
+	return nil
+}
+
+// watch ulanish yoki umumiy kanal yopilishini kutadi va qayta ulanadi. Kanal
+// o'zi yopilganda ham (masalan PRECONDITION_FAILED dan keyin) ulanish butunlay
+// yangilanadi, shunda hook lar qayta ishlaydi.
+func (r *RabbitMQ) watch(ctx context.Context) {
+	for {
+		r.mu.RLock()
+		conn, ch := r.conn, r.ch
+		r.mu.RUnlock()
+
+		connClosed := conn.NotifyClose(make(chan *amqp.Error, 1))
+		chClosed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+		var reason *amqp.Error
+		select {
+		case <-ctx.Done():
+			return
+		case reason = <-connClosed:
+		case reason = <-chClosed:
+		}
+
+		err := errors.New("ulanish yopildi")
+		if reason != nil {
+			err = reason
+		}
+		r.mu.Lock()
+		if r.closed {
+			r.mu.Unlock()
+			return
+		}
+		r.log.Error("RabbitMQ connection lost, reconnecting", "error", err)
+		r.err = err
+		r.ready = make(chan struct{})
+		r.mu.Unlock()
+		conn.Close()
+
+		if err := Retry(ctx, r.backoff, r.log, "rabbitmq", r.connect); err != nil {
+			return
+		}
+	}
+}
+
+// wait ulanish tayyor bo'lguncha kutadi
+func (r *RabbitMQ) wait(ctx context.Context) error {
+	r.mu.RLock()
+	ready := r.ready
+	r.mu.RUnlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Channel umumiy kanal: publish va e'lonlar uchun. Qayta ulanishdan keyin
+// boshqa kanal qaytadi.
+func (r *RabbitMQ) Channel(ctx context.Context) (*amqp.Channel, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.ch, nil
+}
+
+// OpenChannel joriy ulanishda yangi kanal ochadi. Consumer lar uchun: har
+// birining o'z QoS i bo'ladi va bir consumer xatosi boshqalariga ta'sir qilmaydi.
+func (r *RabbitMQ) OpenChannel(ctx context.Context) (*amqp.Channel, error) {
+	if err := r.wait(ctx); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	conn := r.conn
+	r.mu.RUnlock()
+	return conn.Channel()
+}
+
+// Check ulanish holati: ulangan bo'lsa nil, aks holda oxirgi xatolik
+func (r *RabbitMQ) Check(context.Context) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.err
+}
+
+// Close ulanishni yopadi, shundan keyin qayta ulanilmaydi
+func (r *RabbitMQ) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	r.err = errors.New("ulanish yopilgan")
+	if r.conn == nil {
+		return nil
+	}
+	return r.conn.Close()
+}
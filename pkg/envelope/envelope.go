@@ -0,0 +1,144 @@
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KeySize AES-256 kalit uzunligi
+const KeySize = 32
+
+// MasterKeys data key larni o'raydigan master kalitlar. Birinchi kalit faol:
+// yangi data key lar shu bilan o'raladi, qolganlari faqat eski data key larni
+// ochish uchun rotatsiya tugaguncha saqlanadi.
+type MasterKeys struct {
+	activeID string
+	keys     map[string][]byte
+}
+
+// ParseMasterKeys "id:base64" ko'rinishidagi kalitlarni vergul yoki yangi
+// qator bilan ajratilgan holda o'qiydi
+func ParseMasterKeys(spec string) (*MasterKeys, error) {
+	m := &MasterKeys{keys: make(map[string][]byte)}
+	for _, item := range strings.FieldsFunc(spec, func(r rune) bool { return r == ',' || r == '\n' || r == '\r' }) {
+		item = strings.TrimSpace(item)
+		if item == "" || strings.HasPrefix(item, "#") {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(item, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("master key %q id:base64 ko'rinishida bo'lishi kerak", item)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("master key %s base64 emas: %v", id, err)
+		}
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("master key %s uzunligi %d bayt bo'lishi kerak", id, KeySize)
+		}
+		if _, dup := m.keys[id]; dup {
+			return nil, fmt.Errorf("master key %s ikki marta berilgan", id)
+		}
+
+		if m.activeID == "" {
+			m.activeID = id
+		}
+		m.keys[id] = key
+	}
+
+	if m.activeID == "" {
+		return nil, errors.New("master key berilmagan")
+	}
+	return m, nil
+}
+
+// LoadMasterKeys kalitlarni config qiymatidan va fayldan o'qiydi. Ikkalasi ham
+// bo'sh bo'lsa nil qaytadi, ya'ni shifrlash o'chirilgan.
+func LoadMasterKeys(inline, path string) (*MasterKeys, error) {
+	spec := inline
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("master key faylini o'qib bo'lmadi: %v", err)
+		}
+		spec = strings.Join([]string{spec, string(data)}, "\n")
+	}
+	if strings.TrimSpace(spec) == "" {
+		return nil, nil
+	}
+	return ParseMasterKeys(spec)
+}
+
+// ActiveID yangi data key lar o'raladigan master key
+func (m *MasterKeys) ActiveID() string {
+	return m.activeID
+}
+
+// Wrap data key ni faol master key bilan shifrlaydi
+func (m *MasterKeys) Wrap(dataKey []byte) (string, []byte, error) {
+	wrapped, err := Seal(m.keys[m.activeID], dataKey, []byte(m.activeID))
+	if err != nil {
+		return "", nil, err
+	}
+	return m.activeID, wrapped, nil
+}
+
+// Unwrap id master key bilan o'ralgan data key ni ochadi
+func (m *MasterKeys) Unwrap(id string, wrapped []byte) ([]byte, error) {
+	key, ok := m.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("master key %s topilmadi", id)
+	}
+	return Open(key, wrapped, []byte(id))
+}
+
+// NewDataKey tasodifiy AES-256 kalit
+func NewDataKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Seal AES-GCM bilan shifrlaydi. Natija nonce||ciphertext. aad shifrlanmaydi,
+// lekin ochishda aynan shu qiymat berilishi kerak.
+func Seal(key, plaintext, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plaintext)+gcm.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// Open Seal natijasini ochadi
+func Open(key, sealed, aad []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("shifrlangan qiymat juda qisqa")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, aad)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
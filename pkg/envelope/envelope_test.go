@@ -0,0 +1,159 @@
+package envelope
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, KeySize))
+}
+
+func TestParseMasterKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		wantErr  bool
+		activeID string
+	}{
+		{"single", "k1:" + testKey(1), false, "k1"},
+		{"first is active", "k2:" + testKey(2) + ",k1:" + testKey(1), false, "k2"},
+		{"newlines and comments", "# rotated 2026\nk2:" + testKey(2) + "\r\nk1:" + testKey(1) + "\n", false, "k2"},
+		{"empty", "", true, ""},
+		{"only comment", "# none", true, ""},
+		{"missing id", ":" + testKey(1), true, ""},
+		{"missing separator", testKey(1), true, ""},
+		{"not base64", "k1:not-base64!", true, ""},
+		{"short key", "k1:" + base64.StdEncoding.EncodeToString([]byte("short")), true, ""},
+		{"duplicate id", "k1:" + testKey(1) + ",k1:" + testKey(2), true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseMasterKeys(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMasterKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && m.ActiveID() != tt.activeID {
+				t.Errorf("ActiveID() = %q, want %q", m.ActiveID(), tt.activeID)
+			}
+		})
+	}
+}
+
+func TestLoadMasterKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys")
+	if err := os.WriteFile(path, []byte("k2:"+testKey(2)+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := LoadMasterKeys("", "")
+	if err != nil || m != nil {
+		t.Fatalf("LoadMasterKeys(empty) = %v, %v, want nil, nil", m, err)
+	}
+
+	m, err = LoadMasterKeys("k1:"+testKey(1), path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.ActiveID() != "k1" {
+		t.Errorf("ActiveID() = %q, want inline key k1", m.ActiveID())
+	}
+	if _, ok := m.keys["k2"]; !ok {
+		t.Error("key from file was not loaded")
+	}
+
+	if _, err := LoadMasterKeys("", filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("LoadMasterKeys(missing file) error = nil")
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, KeySize)
+	sealed, err := Seal(key, []byte("qon bosimi 120/80"), []byte("user-1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other := bytes.Repeat([]byte{8}, KeySize)
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 1
+
+	tests := []struct {
+		name    string
+		key     []byte
+		sealed  []byte
+		aad     []byte
+		wantErr bool
+	}{
+		{"roundtrip", key, sealed, []byte("user-1"), false},
+		{"wrong aad", key, sealed, []byte("user-2"), true},
+		{"wrong key", other, sealed, []byte("user-1"), true},
+		{"tampered", key, tampered, []byte("user-1"), true},
+		{"too short", key, sealed[:4], []byte("user-1"), true},
+		{"invalid key size", key[:5], sealed, []byte("user-1"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Open(tt.key, tt.sealed, tt.aad)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Open() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && string(got) != "qon bosimi 120/80" {
+				t.Errorf("Open() = %q", got)
+			}
+		})
+	}
+}
+
+func TestSealUsesFreshNonce(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, KeySize)
+	a, _ := Seal(key, []byte("x"), nil)
+	b, _ := Seal(key, []byte("x"), nil)
+	if bytes.Equal(a, b) {
+		t.Error("two Seal calls produced identical output")
+	}
+}
+
+func TestWrapUnwrap(t *testing.T) {
+	m, err := ParseMasterKeys(strings.Join([]string{"k2:" + testKey(2), "k1:" + testKey(1)}, ","))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataKey, err := NewDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, wrapped, err := m.Wrap(dataKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "k2" {
+		t.Errorf("Wrap() id = %q, want active key k2", id)
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr bool
+	}{
+		{"wrapping key", "k2", false},
+		{"other key", "k1", true},
+		{"unknown key", "k3", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.Unwrap(tt.id, wrapped)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unwrap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !bytes.Equal(got, dataKey) {
+				t.Error("Unwrap() returned a different data key")
+			}
+		})
+	}
+}
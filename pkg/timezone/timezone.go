@@ -0,0 +1,85 @@
+package timezone
+
+import (
+	"context"
+	"fmt"
+	"time"
+	// runtime image (alpine, CGO_ENABLED=0) da tzdata yo'q, zonalar binary ga qo'shiladi
+	_ "time/tzdata"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Header chaqiruvchining vaqt zonasi (IANA nomi, masalan "Asia/Tashkent")
+// yuboriladigan metadata kaliti. "Kunlik" hisoblar shu zonadagi kun bo'yicha qilinadi.
+const Header = "x-timezone"
+
+type locationKey struct{}
+
+// NewContext vaqt zonasini ctx ga qo'shadi
+func NewContext(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationKey{}, loc)
+}
+
+// FromContext so'rovda berilgan vaqt zonasini qaytaradi
+func FromContext(ctx context.Context) (*time.Location, bool) {
+	loc, ok := ctx.Value(locationKey{}).(*time.Location)
+	return loc, ok
+}
+
+// Load IANA nomi bo'yicha zonani yuklaydi. "Local" server zonasini bildiradi,
+// chaqiruvchi zonasi emas, shuning uchun qabul qilinmaydi.
+func Load(name string) (*time.Location, error) {
+	if name == "Local" {
+		return nil, fmt.Errorf("unknown time zone %s", name)
+	}
+	return time.LoadLocation(name)
+}
+
+// fromMetadata Header dagi zonani o'qiydi. Header bo'lmasa ctx o'zgarmaydi.
+func fromMetadata(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(Header)
+	if len(values) == 0 || values[0] == "" {
+		return ctx, nil
+	}
+	loc, err := Load(values[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s noto'g'ri vaqt zonasi: %q", Header, values[0])
+	}
+	return NewContext(ctx, loc), nil
+}
+
+// UnaryServerInterceptor so'rovdagi vaqt zonasini ctx ga qo'yadi
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := fromMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor UnaryServerInterceptor ning oqimli RPC lar uchun varianti
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := fromMetadata(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &zonedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type zonedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *zonedStream) Context() context.Context {
+	return s.ctx
+}
@@ -67,6 +67,51 @@ func (s *HealthService) ListMedicalRecords(ctx context.Context,req *pb.ListMedic
 	return resp,nil
 }
 
+func (s *HealthService) RestoreMedicalRecord(ctx context.Context,req *pb.RestoreMedicalRecordRequest)(*pb.RestoreMedicalRecordResponse,error){
+	resp,err:=s.health.RestoreMedicalRecord(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("RestoreMedicalRecord service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListDeletedMedicalRecords(ctx context.Context,req *pb.ListDeletedMedicalRecordsRequest)(*pb.ListDeletedMedicalRecordsResponse,error){
+	resp,err:=s.health.ListDeletedMedicalRecords(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListDeletedMedicalRecords service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) UploadAttachment(stream pb.HealthAnalyticsService_UploadAttachmentServer)error{
+	err:=s.health.UploadAttachment(stream)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("UploadAttachment service da xatolik: %v",err))
+		return err
+	}
+	return nil
+}
+
+func (s *HealthService) DownloadAttachment(req *pb.DownloadAttachmentRequest,stream pb.HealthAnalyticsService_DownloadAttachmentServer)error{
+	err:=s.health.DownloadAttachment(req,stream)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("DownloadAttachment service da xatolik: %v",err))
+		return err
+	}
+	return nil
+}
+
+func (s *HealthService) DeleteAttachment(ctx context.Context,req *pb.DeleteAttachmentRequest)(*pb.DeleteAttachmentResponse,error){
+	resp,err:=s.health.DeleteAttachment(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("DeleteAttachment service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
 func (s *HealthService) AddLifestyleData(ctx context.Context,req *pb.AddLifestyleDataRequest)(*pb.AddLifestyleDataResponse,error){
 	resp,err:=s.health.AddLifestyleData(ctx,req)
 	if err!=nil{
@@ -112,14 +157,41 @@ func (s *HealthService) DeleteLifestyleData(ctx context.Context,req *pb.DeleteLi
 	return resp,nil
 }
 
-// func (s *HealthService) AddWearableData(ctx context.Context,req *pb.AddWearableDataRequest)(*pb.AddWearableDataResponse,error){
-// 	resp,err:=s.health.AddWearableData(ctx,req)
-// 	if err!=nil{
-// 		s.log.Error(fmt.Sprintf("AddWearableData service da xatolik: %v",err))
-// 		return nil,err
-// 	}
-// 	return resp,nil
-// }
+func (s *HealthService) RestoreLifestyleData(ctx context.Context,req *pb.RestoreLifestyleDataRequest)(*pb.RestoreLifestyleDataResponse,error){
+	resp,err:=s.health.RestoreLifestyleData(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("RestoreLifestyleData service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListDeletedLifestyleData(ctx context.Context,req *pb.ListDeletedLifestyleDataRequest)(*pb.ListDeletedLifestyleDataResponse,error){
+	resp,err:=s.health.ListDeletedLifestyleData(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListDeletedLifestyleData service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) AddWearableData(ctx context.Context,req *pb.AddWearableDataRequest)(*pb.AddWearableDataResponse,error){
+	resp,err:=s.health.AddWearableData(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("AddWearableData service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) AddWearableDataBatch(ctx context.Context,req *pb.AddWearableDataBatchRequest)(*pb.AddWearableDataBatchResponse,error){
+	resp,err:=s.health.AddWearableDataBatch(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("AddWearableDataBatch service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
 
 func (s *HealthService) GetAllWearableData(ctx context.Context,req *pb.GetAllWearableDataRequest)(*pb.GetAllWearableDataResponse,error){
 	resp,err:=s.health.GetAllWearableData(ctx,req)
@@ -157,14 +229,32 @@ func (s *HealthService) DeleteWearableData(ctx context.Context,req *pb.DeleteWea
 	return resp,nil
 }
 
-// func (s *HealthService) GenerateHealthRecommendations(ctx context.Context,req *pb.GenerateHealthRecommendationsRequest)(*pb.GenerateHealthRecommendationsResponse,error){
-// 	resp,err:=s.health.GenerateHealthRecommendations(ctx,req)
-// 	if err!=nil{
-// 		s.log.Error(fmt.Sprintf("GenerateHealthRecommendations service da xatolik: %v",err))
-// 		return nil,err
-// 	}
-// 	return resp,nil
-// }
+func (s *HealthService) RestoreWearableData(ctx context.Context,req *pb.RestoreWearableDataRequest)(*pb.RestoreWearableDataResponse,error){
+	resp,err:=s.health.RestoreWearableData(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("RestoreWearableData service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListDeletedWearableData(ctx context.Context,req *pb.ListDeletedWearableDataRequest)(*pb.ListDeletedWearableDataResponse,error){
+	resp,err:=s.health.ListDeletedWearableData(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListDeletedWearableData service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) GenerateHealthRecommendations(ctx context.Context,req *pb.GenerateHealthRecommendationsRequest)(*pb.GenerateHealthRecommendationsResponse,error){
+	resp,err:=s.health.GenerateHealthRecommendations(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("GenerateHealthRecommendations service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
 
 func (s *HealthService) GenerateHealthRecommendationsId(ctx context.Context,req *pb.GenerateHealthRecommendationsIdRequest)(*pb.GenerateHealthRecommendationsIdResponse,error){
 	resp,err:=s.health.GenerateHealthRecommendationsId(ctx,req)
@@ -184,6 +274,15 @@ func (s *HealthService) GetRealtimeHealthMonitoring(ctx context.Context,req *pb.
 	return resp,nil
 }
 
+func (s *HealthService) StreamRealtimeHealthMonitoring(req *pb.StreamRealtimeHealthMonitoringRequest,stream pb.HealthAnalyticsService_StreamRealtimeHealthMonitoringServer)error{
+	err:=s.health.StreamRealtimeHealthMonitoring(req,stream)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("StreamRealtimeHealthMonitoring service da xatolik: %v",err))
+		return err
+	}
+	return nil
+}
+
 func (s *HealthService) GetDailyHealthSummary(ctx context.Context,req *pb.GetDailyHealthSummaryRequest)(*pb.GetDailyHealthSummaryResponse,error){
 	resp,err:=s.health.GetDailyHealthSummary(ctx,req)
 	if err!=nil{
@@ -200,4 +299,166 @@ func (s *HealthService) GetWeeklyHealthSummary(ctx context.Context,req *pb.GetWe
 		return nil,err
 	}
 	return resp,nil
+}
+
+func (s *HealthService) UserIDHealth(ctx context.Context,req *pb.UserIDHealthRequest)(*pb.UserIDHealthResponse,error){
+	resp,err:=s.health.UserIDHealth(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("UserIDHealth service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) CreateAlertThreshold(ctx context.Context,req *pb.CreateAlertThresholdRequest)(*pb.CreateAlertThresholdResponse,error){
+	resp,err:=s.health.CreateAlertThreshold(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("CreateAlertThreshold service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListAlertThresholds(ctx context.Context,req *pb.ListAlertThresholdsRequest)(*pb.ListAlertThresholdsResponse,error){
+	resp,err:=s.health.ListAlertThresholds(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListAlertThresholds service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) UpdateAlertThreshold(ctx context.Context,req *pb.UpdateAlertThresholdRequest)(*pb.UpdateAlertThresholdResponse,error){
+	resp,err:=s.health.UpdateAlertThreshold(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("UpdateAlertThreshold service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) DeleteAlertThreshold(ctx context.Context,req *pb.DeleteAlertThresholdRequest)(*pb.DeleteAlertThresholdResponse,error){
+	resp,err:=s.health.DeleteAlertThreshold(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("DeleteAlertThreshold service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListAlerts(ctx context.Context,req *pb.ListAlertsRequest)(*pb.ListAlertsResponse,error){
+	resp,err:=s.health.ListAlerts(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListAlerts service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) AcknowledgeAlert(ctx context.Context,req *pb.AcknowledgeAlertRequest)(*pb.AcknowledgeAlertResponse,error){
+	resp,err:=s.health.AcknowledgeAlert(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("AcknowledgeAlert service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) GrantDoctorConsent(ctx context.Context,req *pb.GrantDoctorConsentRequest)(*pb.GrantDoctorConsentResponse,error){
+	resp,err:=s.health.GrantDoctorConsent(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("GrantDoctorConsent service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) RevokeDoctorConsent(ctx context.Context,req *pb.RevokeDoctorConsentRequest)(*pb.RevokeDoctorConsentResponse,error){
+	resp,err:=s.health.RevokeDoctorConsent(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("RevokeDoctorConsent service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListDoctorConsents(ctx context.Context,req *pb.ListDoctorConsentsRequest)(*pb.ListDoctorConsentsResponse,error){
+	resp,err:=s.health.ListDoctorConsents(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListDoctorConsents service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListPatientsForDoctor(ctx context.Context,req *pb.ListPatientsForDoctorRequest)(*pb.ListPatientsForDoctorResponse,error){
+	resp,err:=s.health.ListPatientsForDoctor(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListPatientsForDoctor service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListMedicalRecordsByDoctor(ctx context.Context,req *pb.ListMedicalRecordsByDoctorRequest)(*pb.ListMedicalRecordsByDoctorResponse,error){
+	resp,err:=s.health.ListMedicalRecordsByDoctor(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListMedicalRecordsByDoctor service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) QueryAuditLog(ctx context.Context,req *pb.QueryAuditLogRequest)(*pb.QueryAuditLogResponse,error){
+	resp,err:=s.health.QueryAuditLog(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("QueryAuditLog service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) VerifyAuditLog(ctx context.Context,req *pb.VerifyAuditLogRequest)(*pb.VerifyAuditLogResponse,error){
+	resp,err:=s.health.VerifyAuditLog(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("VerifyAuditLog service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ListDeadLetters(ctx context.Context,req *pb.ListDeadLettersRequest)(*pb.ListDeadLettersResponse,error){
+	resp,err:=s.health.ListDeadLetters(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ListDeadLetters service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) ReplayDeadLetters(ctx context.Context,req *pb.ReplayDeadLettersRequest)(*pb.ReplayDeadLettersResponse,error){
+	resp,err:=s.health.ReplayDeadLetters(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("ReplayDeadLetters service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) SetUserTimezone(ctx context.Context,req *pb.SetUserTimezoneRequest)(*pb.SetUserTimezoneResponse,error){
+	resp,err:=s.health.SetUserTimezone(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("SetUserTimezone service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
+}
+
+func (s *HealthService) GetUserTimezone(ctx context.Context,req *pb.GetUserTimezoneRequest)(*pb.GetUserTimezoneResponse,error){
+	resp,err:=s.health.GetUserTimezone(ctx,req)
+	if err!=nil{
+		s.log.Error(fmt.Sprintf("GetUserTimezone service da xatolik: %v",err))
+		return nil,err
+	}
+	return resp,nil
 }
\ No newline at end of file